@@ -1,21 +1,14 @@
 package main
 
 import (
-	_ "embed"
-	"flag"
-	"fmt"
 	"log"
-	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
 
-	. "github.com/stackus/advent-of-code"
+	"github.com/stackus/advent-of-code/runner"
 )
 
-//go:embed input.txt
-var input string
-
 // puzzle1 solves the level 1 puzzle
 func puzzle1(input string) int {
 	calories := parseInput(input)
@@ -62,31 +55,5 @@ func parseInput(input string) (calories []int) {
 
 // -- leave this code alone
 func main() {
-	var puzzle int
-	flag.IntVar(&puzzle, "puzzle", 1, "puzzle number: 1 or 2")
-	flag.Parse()
-
-	// check puzzle is valid
-	if puzzle < 1 || puzzle > 2 {
-		log.Fatalf("Invalid puzzle number: %d", puzzle)
-	}
-
-	// trim input
-	input = strings.TrimRight(input, "\n")
-
-	fmt.Println("Running puzzle", puzzle)
-
-	solution := 0
-	if puzzle == 1 {
-		solution = puzzle1(input)
-	} else {
-		solution = puzzle2(input)
-	}
-
-	solutionPath := filepath.Join(GetPuzzlePath(1, 2022), fmt.Sprintf("solution-%d.txt", puzzle))
-	err := WriteFile(solutionPath, []byte(fmt.Sprintf("%d", solution)))
-	if err != nil {
-		log.Fatalf("Error writing solution: %v", err)
-	}
-	fmt.Println("Solution: ", solution)
+	runner.Run(runner.Puzzle{Day: 1, Year: 2022}, runner.Raw, puzzle1, puzzle2)
 }
@@ -1,31 +1,19 @@
 package main
 
 import (
-	_ "embed"
-	"flag"
-	"fmt"
-	"log"
-	"path/filepath"
 	"strings"
-	"time"
 
-	. "github.com/stackus/advent-of-code"
+	"github.com/stackus/advent-of-code/geometry"
+	"github.com/stackus/advent-of-code/runner"
 )
 
-//go:embed input.txt
-var input string
-
 // puzzle1 solves the level 1 puzzle
 func puzzle1(input string) int {
 	grid := parseInput(input)
 	start := findStartingPosition(grid)
 
-	visited := make([][]bool, len(grid))
-	for i := range visited {
-		visited[i] = make([]bool, len(grid[i]))
-	}
-
-	return bfs(grid, visited, start.x, start.y, isConnected)
+	// the farthest tile is halfway around the loop
+	return len(findLoop(grid, start)) / 2
 }
 
 // puzzle2 solves the level 2 puzzle
@@ -33,179 +21,55 @@ func puzzle2(input string) int {
 	grid := parseInput(input)
 	start := findStartingPosition(grid)
 
-	visited := make([][]bool, len(grid))
-	for i := range visited {
-		visited[i] = make([]bool, len(grid[i]))
-	}
-
-	// solve again to fill visited
-	_ = bfs(grid, visited, start.x, start.y, isConnected)
-
-	total := 0
-
-	exploded := constructExplodedGrid(grid, visited)
-
-	// ASCII art!
-	// for _, row := range exploded {
-	// 	fmt.Println(string(row))
-	// }
-
-	revisited := make([][]bool, len(exploded))
-	for i := range revisited {
-		revisited[i] = make([]bool, len(exploded[i]))
-	}
-
-	_ = bfs(exploded, revisited, 0, 0, func(from, to point, grid [][]rune) bool {
-		if to.x < 0 || to.y < 0 || to.y >= len(grid) || to.x >= len(grid[0]) {
-			return false
-		}
-		return grid[to.y][to.x] == ' '
-	})
-
-	// // Filled in ASCII art!
-	// for y, row := range exploded {
-	// 	for x, char := range row {
-	// 		if char == '*' {
-	// 			fmt.Print("*")
-	// 			continue
-	// 		}
-	// 		if revisited[y][x] {
-	// 			fmt.Print("O")
-	// 			continue
-	// 		}
-	// 		fmt.Print(" ")
-	// 	}
-	// 	fmt.Println()
-	// }
-
-	for y, row := range grid {
-		for x := range row {
-			if visited[y][x] {
-				continue
-			}
-			if allPointsAreNotRevisited([]point{
-				{y: y * 3, x: x * 3}, {y: y * 3, x: x*3 + 1}, {y: y * 3, x: x*3 + 2},
-				{y: y*3 + 1, x: x * 3}, {y: y*3 + 1, x: x*3 + 1}, {y: y*3 + 1, x: x*3 + 2},
-				{y: y*3 + 2, x: x * 3}, {y: y*3 + 2, x: x*3 + 1}, {y: y*3 + 2, x: x*3 + 2},
-			}, revisited) {
-				total++
-			}
-		}
-	}
-
-	return total
+	// the tiles enclosed by the loop are the lattice points inside it
+	return findLoop(grid, start).Interior()
 }
 
 type point struct {
-	y, x, distance int
+	y, x int
 }
 
-func allPointsAreNotRevisited(points []point, grid [][]bool) bool {
-	for _, point := range points {
-		if grid[point.y][point.x] {
-			return false
+// findLoop follows the pipes from the start and returns the tiles of the loop in order
+//
+// Pipes next to the start may point into it without being part of the loop, so each of them is tried until one
+// leads back to the start
+func findLoop(grid [][]rune, start point) geometry.Polygon[int] {
+	for _, dir := range getPossibleDirections('S') {
+		first := point{x: start.x + dir.x, y: start.y + dir.y}
+		if !isConnected(start, first, grid) {
+			continue
+		}
+		if loop, ok := walkLoop(grid, start, first); ok {
+			return loop
 		}
-	}
-	return true
-}
-
-func constructExplodedGrid(grid [][]rune, visited [][]bool) [][]rune {
-	exploded := make([][]rune, len(grid)*3)
-	for i := range exploded {
-		exploded[i] = make([]rune, len(grid[0])*3)
 	}
 
-	for y, row := range grid {
-		for x, char := range row {
-			if !visited[y][x] {
-				exploded[y*3+0][x*3+0] = ' '
-				exploded[y*3+0][x*3+1] = ' '
-				exploded[y*3+0][x*3+2] = ' '
-				exploded[y*3+1][x*3+0] = ' '
-				exploded[y*3+1][x*3+1] = ' '
-				exploded[y*3+1][x*3+2] = ' '
-				exploded[y*3+2][x*3+0] = ' '
-				exploded[y*3+2][x*3+1] = ' '
-				exploded[y*3+2][x*3+2] = ' '
-				continue
-			}
-			switch char {
-			case '|':
-				exploded[y*3+0][x*3+0] = ' '
-				exploded[y*3+0][x*3+1] = '*'
-				exploded[y*3+0][x*3+2] = ' '
-				exploded[y*3+1][x*3+0] = ' '
-				exploded[y*3+1][x*3+1] = '*'
-				exploded[y*3+1][x*3+2] = ' '
-				exploded[y*3+2][x*3+0] = ' '
-				exploded[y*3+2][x*3+1] = '*'
-				exploded[y*3+2][x*3+2] = ' '
-			case '-':
-				exploded[y*3+0][x*3+0] = ' '
-				exploded[y*3+0][x*3+1] = ' '
-				exploded[y*3+0][x*3+2] = ' '
-				exploded[y*3+1][x*3+0] = '*'
-				exploded[y*3+1][x*3+1] = '*'
-				exploded[y*3+1][x*3+2] = '*'
-				exploded[y*3+2][x*3+0] = ' '
-				exploded[y*3+2][x*3+1] = ' '
-				exploded[y*3+2][x*3+2] = ' '
-			case 'L':
-				exploded[y*3+0][x*3+0] = ' '
-				exploded[y*3+0][x*3+1] = '*'
-				exploded[y*3+0][x*3+2] = ' '
-				exploded[y*3+1][x*3+0] = ' '
-				exploded[y*3+1][x*3+1] = '*'
-				exploded[y*3+1][x*3+2] = '*'
-				exploded[y*3+2][x*3+0] = ' '
-				exploded[y*3+2][x*3+1] = ' '
-				exploded[y*3+2][x*3+2] = ' '
-			case 'J':
-				exploded[y*3+0][x*3+0] = ' '
-				exploded[y*3+0][x*3+1] = '*'
-				exploded[y*3+0][x*3+2] = ' '
-				exploded[y*3+1][x*3+0] = '*'
-				exploded[y*3+1][x*3+1] = '*'
-				exploded[y*3+1][x*3+2] = ' '
-				exploded[y*3+2][x*3+0] = ' '
-				exploded[y*3+2][x*3+1] = ' '
-				exploded[y*3+2][x*3+2] = ' '
-			case '7':
-				exploded[y*3+0][x*3+0] = ' '
-				exploded[y*3+0][x*3+1] = ' '
-				exploded[y*3+0][x*3+2] = ' '
-				exploded[y*3+1][x*3+0] = '*'
-				exploded[y*3+1][x*3+1] = '*'
-				exploded[y*3+1][x*3+2] = ' '
-				exploded[y*3+2][x*3+0] = ' '
-				exploded[y*3+2][x*3+1] = '*'
-				exploded[y*3+2][x*3+2] = ' '
-			case 'F':
-				exploded[y*3+0][x*3+0] = ' '
-				exploded[y*3+0][x*3+1] = ' '
-				exploded[y*3+0][x*3+2] = ' '
-				exploded[y*3+1][x*3+0] = ' '
-				exploded[y*3+1][x*3+1] = '*'
-				exploded[y*3+1][x*3+2] = '*'
-				exploded[y*3+2][x*3+0] = ' '
-				exploded[y*3+2][x*3+1] = '*'
-				exploded[y*3+2][x*3+2] = ' '
-			case 'S':
-				exploded[y*3+0][x*3+0] = '*'
-				exploded[y*3+0][x*3+1] = '*'
-				exploded[y*3+0][x*3+2] = '*'
-				exploded[y*3+1][x*3+0] = '*'
-				exploded[y*3+1][x*3+1] = '*'
-				exploded[y*3+1][x*3+2] = '*'
-				exploded[y*3+2][x*3+0] = '*'
-				exploded[y*3+2][x*3+1] = '*'
-				exploded[y*3+2][x*3+2] = '*'
+	return nil
+}
 
+// walkLoop walks the pipes from first, always away from the tile it came from, and returns the tiles passed on the
+// way back to the start; it reports false when the pipes come to a dead end
+func walkLoop(grid [][]rune, start, first point) (geometry.Polygon[int], bool) {
+	loop := geometry.Polygon[int]{geometry.Pt(start.x, start.y)}
+	previous, current := start, first
+	for current != start {
+		loop = append(loop, geometry.Pt(current.x, current.y))
+
+		next, found := current, false
+		for _, dir := range getPossibleDirections(grid[current.y][current.x]) {
+			to := point{x: current.x + dir.x, y: current.y + dir.y}
+			if to != previous && isConnected(current, to, grid) {
+				next, found = to, true
+				break
 			}
 		}
+		if !found {
+			return nil, false
+		}
+		previous, current = current, next
 	}
 
-	return exploded
+	return loop, true
 }
 
 func findStartingPosition(grid [][]rune) point {
@@ -219,32 +83,6 @@ func findStartingPosition(grid [][]rune) point {
 	return point{}
 }
 
-func bfs(grid [][]rune, visited [][]bool, x, y int, canVisitFn func(from, to point, grid [][]rune) bool) int {
-	queue := []point{{x: x, y: y, distance: 0}}
-	maxDistance := 0
-
-	for len(queue) > 0 {
-		from := queue[0]
-		queue = queue[1:]
-
-		if visited[from.y][from.x] {
-			continue
-		}
-
-		visited[from.y][from.x] = true
-		maxDistance = from.distance
-
-		for _, dir := range getPossibleDirections(grid[from.y][from.x]) {
-			to := point{x: from.x + dir.x, y: from.y + dir.y, distance: from.distance + 1}
-			if canVisitFn(from, to, grid) && !visited[to.y][to.x] {
-				queue = append(queue, to)
-			}
-		}
-	}
-
-	return maxDistance
-}
-
 func getPossibleDirections(char rune) []point {
 	switch char {
 	case '|':
@@ -259,7 +97,7 @@ func getPossibleDirections(char rune) []point {
 		return []point{{y: 1, x: 0}, {y: 0, x: -1}}
 	case 'F':
 		return []point{{y: 1, x: 0}, {y: 0, x: 1}}
-	case 'S', ' ':
+	case 'S':
 		return []point{{y: -1, x: 0}, {y: 1, x: 0}, {y: 0, x: -1}, {y: 0, x: 1}}
 	default:
 		return []point{}
@@ -301,33 +139,5 @@ func parseInput(input string) [][]rune {
 
 // -- leave this code alone
 func main() {
-	var puzzle int
-	flag.IntVar(&puzzle, "puzzle", 1, "puzzle number: 1 or 2")
-	flag.Parse()
-
-	// check puzzle is valid
-	if puzzle < 1 || puzzle > 2 {
-		log.Fatalf("Invalid puzzle number: %d", puzzle)
-	}
-
-	// trim input
-	input = strings.TrimRight(input, "\n")
-
-	fmt.Println("Running puzzle", puzzle)
-
-	started := time.Now()
-	solution := 0
-	if puzzle == 1 {
-		solution = puzzle1(input)
-	} else {
-		solution = puzzle2(input)
-	}
-	fmt.Println("Completed in", time.Since(started))
-
-	solutionPath := filepath.Join(GetPuzzlePath(10, 2023), fmt.Sprintf("solution-%d.txt", puzzle))
-	err := WriteFile(solutionPath, []byte(fmt.Sprintf("%d", solution)), true)
-	if err != nil {
-		log.Fatalf("Error writing solution: %v", err)
-	}
-	fmt.Println("Solution:", solution)
+	runner.Run(runner.Puzzle{Day: 10, Year: 2023}, runner.Raw, puzzle1, puzzle2)
 }
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+// lines joins the rows of a sketch of the pipes
+func lines(rows ...string) string {
+	return strings.Join(rows, "\n")
+}
+
+func TestPuzzle1(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  int
+	}{
+		{name: "simple loop", input: lines(".....", ".S-7.", ".|.|.", ".L-J.", "....."), want: 4},
+		{name: "complex loop", input: lines("..F7.", ".FJ|.", "SJ.L7", "|F--J", "LJ..."), want: 8},
+		{name: "stray pipe into the start", input: lines(".|...", ".S-7.", ".|.|.", ".L-J.", "....."), want: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := puzzle1(tt.input); got != tt.want {
+				t.Errorf("puzzle1() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPuzzle2(t *testing.T) {
+	box := []string{
+		".........",
+		".S-----7.",
+		".|.....|.",
+		".|.....|.",
+		".L-----J.",
+	}
+	// a pipe above the start points into it without being part of the loop
+	strayAbove := append([]string{".|......."}, box[1:]...)
+	// and so does one beside it that runs off the edge of the grid
+	strayBeside := []string{
+		".........",
+		"-S-----7.",
+		".|.....|.",
+		".|.....|.",
+		".L-----J.",
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		want  int
+	}{
+		{name: "box", input: lines(box...), want: 10},
+		{name: "stray pipe above the start", input: lines(strayAbove...), want: 10},
+		{name: "stray pipes beside the start", input: lines(strayBeside...), want: 10},
+		{name: "squeezing between pipes", input: lines(
+			"...........",
+			".S-------7.",
+			".|F-----7|.",
+			".||.....||.",
+			".||.....||.",
+			".|L-7.F-J|.",
+			".|..|.|..|.",
+			".L--J.L--J.",
+			"...........",
+		), want: 4},
+		{name: "junk pipes", input: lines(
+			"FF7FSF7F7F7F7F7F---7",
+			"L|LJ||||||||||||F--J",
+			"FL-7LJLJ||||||LJL-77",
+			"F--JF--7||LJLJ7F7FJ-",
+			"L---JF-JLJ.||-FJLJJ7",
+			"|F|F-JF---7F7-L7L|7|",
+			"|FFJF7L7F-JF7|JL---7",
+			"7-L-JL7||F7|L7F-7F7|",
+			"L.L7LFJ|||||FJL7||LJ",
+			"L7JLJL-JLJLJL--JLJ.L",
+		), want: 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := puzzle2(tt.input); got != tt.want {
+				t.Errorf("puzzle2() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,38 +1,17 @@
 package main
 
 import (
-	_ "embed"
-	"flag"
-	"fmt"
-	"log"
-	"path/filepath"
-	"strings"
-	"time"
-
-	. "github.com/stackus/advent-of-code"
+	"github.com/stackus/advent-of-code/grid"
+	"github.com/stackus/advent-of-code/runner"
 )
 
-//go:embed input.txt
-var input string
-
 // puzzle1 solves the level 1 puzzle
 func puzzle1(input string) int {
 	field := parseInput(input)
 
-	// // print field
-	// for _, line := range field {
-	// 	fmt.Println(string(line))
-	// }
-
 	// tilt north
 	tiltNorth(field)
 
-	// fmt.Println()
-	// // print field
-	// for _, line := range field {
-	// 	fmt.Println(string(line))
-	// }
-
 	return totalField(field)
 }
 
@@ -40,21 +19,17 @@ func puzzle1(input string) int {
 func puzzle2(input string) int {
 	field := parseInput(input)
 
-	// tilt north
 	rotations := 1_000_000_000
 	seen := map[string][]int{}
 	for r := 0; r < rotations; r++ {
-		// rotate the field
-		tiltNorth(field)
-		tiltWest(field)
-		tiltSouth(field)
-		tiltEast(field)
-
-		builder := strings.Builder{}
-		for _, line := range field {
-			builder.WriteString(string(line))
+		// tilt north, west, south and east; turning the field clockwise after each tilt brings the next direction
+		// to the top, and after four turns the field is back the right way up
+		for i := 0; i < 4; i++ {
+			tiltNorth(field)
+			field = field.RotateClockwise()
 		}
-		s := builder.String()
+
+		s := field.String()
 
 		// for the code below; add 1 to r because we've already performed the rotation above
 
@@ -74,110 +49,30 @@ func puzzle2(input string) int {
 	return 0
 }
 
-func totalField(field [][]rune) int {
+func totalField(field *grid.Grid[rune]) int {
 	total := 0
-	lines := len(field)
-	for i, line := range field {
-		total += (lines - i) * strings.Count(string(line), "O")
+	for _, rock := range field.FindAll(func(r rune) bool { return r == 'O' }) {
+		total += field.Height - rock.Y
 	}
 	return total
 }
 
-func tiltNorth(field [][]rune) {
-	for x := 0; x < len(field[0]); x++ {
-		var open []int
-		for y := 0; y < len(field); y++ {
-			if field[y][x] == '.' {
-				open = append(open, y)
-				continue
-			}
-			if field[y][x] == 'O' {
-				if len(open) > 0 {
-					field[y][x] = '.'
-					field[open[0]][x] = 'O'
-					open = open[1:]
-					open = append(open, y)
-				}
-				continue
-			}
-			if field[y][x] == '#' {
-				open = []int{}
-				continue
-			}
-		}
-	}
-}
-
-func tiltWest(field [][]rune) {
-	for y := 0; y < len(field); y++ {
-		var open []int
-		for x := 0; x < len(field[y]); x++ {
-			if field[y][x] == '.' {
-				open = append(open, x)
-				continue
-			}
-			if field[y][x] == 'O' {
-				if len(open) > 0 {
-					field[y][x] = '.'
-					field[y][open[0]] = 'O'
-					open = open[1:]
-					open = append(open, x)
-				}
-				continue
-			}
-			if field[y][x] == '#' {
-				open = []int{}
-				continue
-			}
-		}
-	}
-}
-
-func tiltSouth(field [][]rune) {
-	for x := 0; x < len(field[0]); x++ {
+func tiltNorth(field *grid.Grid[rune]) {
+	for x := 0; x < field.Width; x++ {
 		var open []int
-		for y := len(field) - 1; y >= 0; y-- {
-			if field[y][x] == '.' {
+		for y := 0; y < field.Height; y++ {
+			switch field.Get(x, y) {
+			case '.':
 				open = append(open, y)
-				continue
-			}
-			if field[y][x] == 'O' {
+			case 'O':
 				if len(open) > 0 {
-					field[y][x] = '.'
-					field[open[0]][x] = 'O'
+					field.Set(x, y, '.')
+					field.Set(x, open[0], 'O')
 					open = open[1:]
 					open = append(open, y)
 				}
-				continue
-			}
-			if field[y][x] == '#' {
+			case '#':
 				open = []int{}
-				continue
-			}
-		}
-	}
-}
-
-func tiltEast(field [][]rune) {
-	for y := 0; y < len(field); y++ {
-		var open []int
-		for x := len(field[y]) - 1; x >= 0; x-- {
-			if field[y][x] == '.' {
-				open = append(open, x)
-				continue
-			}
-			if field[y][x] == 'O' {
-				if len(open) > 0 {
-					field[y][x] = '.'
-					field[y][open[0]] = 'O'
-					open = open[1:]
-					open = append(open, x)
-				}
-				continue
-			}
-			if field[y][x] == '#' {
-				open = []int{}
-				continue
 			}
 		}
 	}
@@ -185,45 +80,11 @@ func tiltEast(field [][]rune) {
 
 // parseInput converts the input string into whatever format is needed for the puzzle
 // update the return type as needed
-func parseInput(input string) [][]rune {
-	field := [][]rune{}
-
-	for _, line := range strings.Split(input, "\n") {
-		field = append(field, []rune(line))
-	}
-
-	return field
+func parseInput(input string) *grid.Grid[rune] {
+	return grid.Parse(input)
 }
 
 // -- leave this code alone
 func main() {
-	var puzzle int
-	flag.IntVar(&puzzle, "puzzle", 1, "puzzle number: 1 or 2")
-	flag.Parse()
-
-	// check puzzle is valid
-	if puzzle < 1 || puzzle > 2 {
-		log.Fatalf("Invalid puzzle number: %d", puzzle)
-	}
-
-	// trim input
-	input = strings.TrimRight(input, "\n")
-
-	fmt.Println("Running puzzle", puzzle)
-
-	started := time.Now()
-	solution := 0
-	if puzzle == 1 {
-		solution = puzzle1(input)
-	} else {
-		solution = puzzle2(input)
-	}
-	fmt.Println("Completed in", time.Since(started))
-
-	solutionPath := filepath.Join(GetPuzzlePath(14, 2023), fmt.Sprintf("solution-%d.txt", puzzle))
-	err := WriteFile(solutionPath, []byte(fmt.Sprintf("%d", solution)), true)
-	if err != nil {
-		log.Fatalf("Error writing solution: %v", err)
-	}
-	fmt.Println("Solution:", solution)
+	runner.Run(runner.Puzzle{Day: 14, Year: 2023}, runner.Raw, puzzle1, puzzle2)
 }
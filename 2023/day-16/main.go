@@ -1,45 +1,42 @@
 package main
 
 import (
-	_ "embed"
-	"flag"
-	"fmt"
-	"log"
-	"path/filepath"
-	"strings"
-	"time"
-
-	. "github.com/stackus/advent-of-code"
+	"github.com/stackus/advent-of-code/geometry"
+	"github.com/stackus/advent-of-code/grid"
+	"github.com/stackus/advent-of-code/runner"
 )
 
-//go:embed input.txt
-var input string
+// beam is a beam of light entering a tile while heading in a direction
+type beam struct {
+	at      geometry.Point[int]
+	heading geometry.Direction
+}
 
 // puzzle1 solves the level 1 puzzle
 func puzzle1(input string) int {
-	grid := parseInput(input)
-	total := energizeNodes(grid, [][4]int{{0, -1, 0, 1}})
+	contraption := parseInput(input)
+	total := energizeNodes(contraption, beam{at: geometry.Pt(-1, 0), heading: geometry.Right})
 
 	return total
 }
 
 // puzzle2 solves the level 2 puzzle
 func puzzle2(input string) int {
-	grid := parseInput(input)
+	contraption := parseInput(input)
 
 	total := 0
 
-	for y := 0; y < len(grid); y++ {
+	for y := 0; y < contraption.Height; y++ {
 		// in from the left
-		total = max(total, energizeNodes(grid, [][4]int{{y, -1, 0, 1}}))
+		total = max(total, energizeNodes(contraption, beam{at: geometry.Pt(-1, y), heading: geometry.Right}))
 		// in from the right
-		total = max(total, energizeNodes(grid, [][4]int{{y, len(grid[0]), 0, -1}}))
+		total = max(total, energizeNodes(contraption, beam{at: geometry.Pt(contraption.Width, y), heading: geometry.Left}))
 	}
-	for x := 0; x < len(grid[0]); x++ {
+	for x := 0; x < contraption.Width; x++ {
 		// in from the top
-		total = max(total, energizeNodes(grid, [][4]int{{-1, x, 1, 0}}))
+		total = max(total, energizeNodes(contraption, beam{at: geometry.Pt(x, -1), heading: geometry.Down}))
 		// in from the bottom
-		total = max(total, energizeNodes(grid, [][4]int{{len(grid), x, -1, 0}}))
+		total = max(total, energizeNodes(contraption, beam{at: geometry.Pt(x, contraption.Height), heading: geometry.Up}))
 	}
 
 	return total
@@ -47,102 +44,59 @@ func puzzle2(input string) int {
 
 // parseInput converts the input string into whatever format is needed for the puzzle
 // update the return type as needed
-func parseInput(input string) (lines [][]rune) {
-	for _, line := range strings.Split(input, "\n") {
-		lines = append(lines, []rune(line))
-	}
-
-	return
+func parseInput(input string) *grid.Grid[rune] {
+	return grid.Parse(input)
 }
 
-func energizeNodes(grid [][]rune, queue [][4]int) int {
-	// map of point+direction to boolean
-	// [y,x, yDir, xDir]
-	// yDir and xDir are -1, 0, or 1
-	visited := map[[4]int]bool{}
+// energizeNodes follows the beam from just outside the contraption and counts the tiles it passes through
+func energizeNodes(contraption *grid.Grid[rune], start beam) int {
+	visited := map[beam]bool{}
 
+	queue := []beam{start}
 	for len(queue) > 0 {
 		item := queue[0]
 		queue = queue[1:]
 
-		dirY := item[2]
-		dirX := item[3]
-		nextY := item[0] + dirY
-		nextX := item[1] + dirX
-
-		move := [4]int{nextY, nextX, dirY, dirX}
-		if visited[move] {
+		next := beam{at: item.at.Step(item.heading), heading: item.heading}
+		if visited[next] {
 			continue
 		}
-		if nextY < 0 || nextX < 0 || nextY >= len(grid) || nextX >= len(grid[0]) {
+		tile, ok := contraption.Lookup(next.at.X, next.at.Y)
+		if !ok {
 			continue
 		}
-		visited[move] = true
-		switch grid[nextY][nextX] {
-		case '.':
-			// moving left or right is not changed
-			// moving up and down is not changed
-			queue = append(queue, [4]int{nextY, nextX, dirY, dirX})
-		case '/':
-			// moving to the right (0, 1) becomes up (-1, 0)
-			// moving to the left (0, -1) becomes down (1, 0)
-			// moving up (-1, 0) becomes right (0, 1)
-			// moving down (1, 0) becomes left (0, -1)
-			dirY, dirX = -dirX, -dirY
-			queue = append(queue, [4]int{nextY, nextX, dirY, dirX})
-		case '\\':
-			// moving to the right (0, 1) becomes down (1, 0)
-			// moving to the left (0, -1) becomes up (-1, 0)
-			// moving up (-1, 0) becomes left (0, -1)
-			// moving down (1, 0) becomes right (0, 1)
-			dirY, dirX = dirX, dirY
-			queue = append(queue, [4]int{nextY, nextX, dirY, dirX})
-		case '-':
-			// moving left or right is not changed
-			// moving up and down will split into two moves heading left and right
-			queue = append(queue, [4]int{nextY, nextX, 0, 1}, [4]int{nextY, nextX, 0, -1})
-		case '|':
-			// moving up or down is not changed
-			// moving left and right will split into two moves heading up and down
-			queue = append(queue, [4]int{nextY, nextX, 1, 0}, [4]int{nextY, nextX, -1, 0})
+		visited[next] = true
+
+		horizontal := next.heading == geometry.Left || next.heading == geometry.Right
+		switch {
+		case tile == '/' && horizontal, tile == '\\' && !horizontal:
+			// moving right becomes up on '/' and moving up becomes left on '\'
+			next.heading = next.heading.TurnLeft()
+			queue = append(queue, next)
+		case tile == '/', tile == '\\':
+			// moving up becomes right on '/' and moving right becomes down on '\'
+			next.heading = next.heading.TurnRight()
+			queue = append(queue, next)
+		case tile == '-' && !horizontal, tile == '|' && horizontal:
+			// the beam splits into two heading off to either side
+			queue = append(queue,
+				beam{at: next.at, heading: next.heading.TurnLeft()},
+				beam{at: next.at, heading: next.heading.TurnRight()},
+			)
+		default:
+			// empty space, or a splitter met end on
+			queue = append(queue, next)
 		}
 	}
-	nodes := map[[2]int]bool{}
+
+	nodes := map[geometry.Point[int]]bool{}
 	for visited := range visited {
-		nodes[[2]int{visited[0], visited[1]}] = true
+		nodes[visited.at] = true
 	}
 	return len(nodes)
 }
 
 // -- leave this code alone
 func main() {
-	var puzzle int
-	flag.IntVar(&puzzle, "puzzle", 1, "puzzle number: 1 or 2")
-	flag.Parse()
-
-	// check puzzle is valid
-	if puzzle < 1 || puzzle > 2 {
-		log.Fatalf("Invalid puzzle number: %d", puzzle)
-	}
-
-	// trim input
-	input = strings.TrimRight(input, "\n")
-
-	fmt.Println("Running puzzle", puzzle)
-
-	started := time.Now()
-	solution := 0
-	if puzzle == 1 {
-		solution = puzzle1(input)
-	} else {
-		solution = puzzle2(input)
-	}
-	fmt.Println("Completed in", time.Since(started))
-
-	solutionPath := filepath.Join(GetPuzzlePath(16, 2023), fmt.Sprintf("solution-%d.txt", puzzle))
-	err := WriteFile(solutionPath, []byte(fmt.Sprintf("%d", solution)), true)
-	if err != nil {
-		log.Fatalf("Error writing solution: %v", err)
-	}
-	fmt.Println("Solution:", solution)
+	runner.Run(runner.Puzzle{Day: 16, Year: 2023}, runner.Raw, puzzle1, puzzle2)
 }
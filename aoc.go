@@ -1,61 +1,67 @@
 package advent_of_code
 
 import (
-	"context"
-	"flag"
+	"errors"
 	"fmt"
-	"io"
 	"log"
-	"net/http"
 	"os"
 	"path/filepath"
-	"runtime"
-	"time"
+	"strings"
 )
 
-func ParseFlags() (int, int) {
-	today := time.Now()
+// RootMarker is a file that marks the repository root, for checkouts where go.mod is not at the root
+const RootMarker = ".aoc-root"
 
-	// use ET timezone
-	loc, err := time.LoadLocation("America/New_York")
+var ErrRootNotFound = errors.New("repository root not found")
+
+// FindRoot returns the repository root: AOC_ROOT when it is set, otherwise the closest directory at or above the
+// working directory holding a RootMarker or go.mod file
+func FindRoot() (string, error) {
+	if root := os.Getenv("AOC_ROOT"); root != "" {
+		return filepath.Abs(root)
+	}
+
+	dir, err := os.Getwd()
 	if err != nil {
-		log.Fatalf("Error loading timezone: %v", err)
+		return "", fmt.Errorf("error getting working directory: %w", err)
 	}
-	today = today.In(loc)
 
-	day := flag.Int("day", today.Day(), "day of the month")
-	year := flag.Int("year", today.Year(), "year")
-	flag.Parse()
+	for {
+		for _, marker := range []string{RootMarker, "go.mod"} {
+			if _, err = os.Stat(filepath.Join(dir, marker)); err == nil {
+				return dir, nil
+			}
+		}
 
-	// day must be between 1 and 25
-	if *day < 1 || *day > 25 {
-		log.Fatalf("Invalid day of the month: %d", *day)
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("%w: no %s or go.mod above the working directory and AOC_ROOT is not set",
+				ErrRootNotFound, RootMarker)
+		}
+		dir = parent
 	}
+}
 
-	// year must be between 2015 and 2023
-	if *year < 2015 || *year > 2023 {
-		log.Fatalf("Invalid year: %d", *year)
+func GetRootPath() string {
+	root, err := FindRoot()
+	if err != nil {
+		log.Fatalf("Error finding root: %v", err)
 	}
 
-	return *day, *year
+	return root
 }
 
-func GetPuzzlePath(day, year int) string {
-	_, caller, _, ok := runtime.Caller(1)
-	if !ok {
-		log.Fatalf("Error getting caller")
-	}
+// PuzzlePath returns the directory for the puzzle of the given day and year under root
+func PuzzlePath(root string, day, year int) string {
+	return filepath.Join(root, fmt.Sprintf("%d", year), fmt.Sprintf("day-%02d", day))
+}
 
-	return filepath.Join(filepath.Dir(caller), fmt.Sprintf("../../%d/day-%02d", year, day))
+func GetPuzzlePath(day, year int) string {
+	return PuzzlePath(GetRootPath(), day, year)
 }
 
 func MakeDir(day, year int) string {
-	_, caller, _, ok := runtime.Caller(1)
-	if !ok {
-		log.Fatalf("Error getting caller")
-	}
-
-	puzzlePath := filepath.Join(filepath.Dir(caller), fmt.Sprintf("../../%d/day-%02d", year, day))
+	puzzlePath := GetPuzzlePath(day, year)
 
 	err := os.MkdirAll(puzzlePath, 0755)
 	if err != nil {
@@ -85,56 +91,32 @@ func WriteFile(filename string, contents []byte, allowOverwrite bool) error {
 	return nil
 }
 
-func DoGet(url string) ([]byte, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+// LoadEnvFile sets the KEY=value pairs from the file as environment variables, leaving variables that are already
+// set alone
+func LoadEnvFile(filename string) error {
+	contents, err := os.ReadFile(filename)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
+		return err
 	}
 
-	session := os.Getenv("AOC_SESSION")
-	if session == "" {
-		return nil, fmt.Errorf("AOC_SESSION environment variable is not set")
-	}
-
-	req.AddCookie(&http.Cookie{Name: "session", Value: session})
-
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-
-	defer resp.Body.Close()
-
-	return io.ReadAll(resp.Body)
-}
-
-func DoPost(url string, body io.Reader) ([]byte, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
-	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
-	}
-
-	session := os.Getenv("AOC_SESSION")
-	if session == "" {
-		return nil, fmt.Errorf("AOC_SESSION environment variable is not set")
-	}
-
-	req.AddCookie(&http.Cookie{Name: "session", Value: session})
+	for _, line := range strings.Split(string(contents), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
 
-	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+		key, value, found := strings.Cut(strings.TrimPrefix(line, "export "), "=")
+		if !found {
+			return fmt.Errorf("invalid line in %s: %q", filename, line)
+		}
+		key, value = strings.TrimSpace(key), strings.Trim(strings.TrimSpace(value), `"'`)
 
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return nil, err
+		if _, set := os.LookupEnv(key); !set {
+			if err = os.Setenv(key, value); err != nil {
+				return err
+			}
+		}
 	}
 
-	defer resp.Body.Close()
-
-	return io.ReadAll(resp.Body)
+	return nil
 }
@@ -0,0 +1,162 @@
+package advent_of_code
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Cache resources
+const (
+	CacheInput  = "input"
+	CachePuzzle = "puzzle"
+)
+
+// CacheKey identifies a single cached resource for a day
+type CacheKey struct {
+	Year     int
+	Day      int
+	Resource string
+}
+
+func (k CacheKey) String() string {
+	return fmt.Sprintf("%d/day-%02d/%s", k.Year, k.Day, k.Resource)
+}
+
+// CacheEntry is a cached response along with the metadata needed to revalidate it
+type CacheEntry struct {
+	URL          string    `json:"url"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"lastModified,omitempty"`
+	FetchedAt    time.Time `json:"fetchedAt"`
+	Body         []byte    `json:"-"`
+}
+
+// Cache stores fetched resources on disk so that they are only downloaded once
+type Cache struct {
+	Dir string
+}
+
+// DefaultCacheDir returns AOC_CACHE_DIR when set, otherwise a directory in the user cache directory
+func DefaultCacheDir() (string, error) {
+	if dir := os.Getenv("AOC_CACHE_DIR"); dir != "" {
+		return dir, nil
+	}
+
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("error locating cache directory: %w", err)
+	}
+
+	return filepath.Join(dir, "advent-of-code"), nil
+}
+
+// SessionCacheDir returns the directory within dir that caches the downloads made with a session given directly rather
+// than through a profile; it is named after a hash of the session, so that the cookie itself isn't written to disk
+func SessionCacheDir(dir, session string) string {
+	sum := sha256.Sum256([]byte(session))
+
+	return filepath.Join(dir, "sessions", hex.EncodeToString(sum[:8]))
+}
+
+// Load returns the cached entry for the key; the error wraps os.ErrNotExist when nothing is cached
+func (c *Cache) Load(key CacheKey) (*CacheEntry, error) {
+	path := filepath.Join(c.Dir, filepath.FromSlash(key.String()))
+
+	meta, err := os.ReadFile(path + ".json")
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &CacheEntry{}
+	if err = json.Unmarshal(meta, entry); err != nil {
+		return nil, fmt.Errorf("error reading cache metadata for %s: %w", key, err)
+	}
+
+	entry.Body, err = os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// Store saves the entry for the key, replacing anything already cached
+func (c *Cache) Store(key CacheKey, entry *CacheEntry) error {
+	path := filepath.Join(c.Dir, filepath.FromSlash(key.String()))
+
+	meta, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding cache metadata for %s: %w", key, err)
+	}
+
+	// the body is written first so that metadata never points at a missing body
+	if err = WriteFile(path, entry.Body, true); err != nil {
+		return err
+	}
+
+	return WriteFile(path+".json", meta, true)
+}
+
+// fetch returns the cached copy of the resource when one exists, otherwise it downloads and caches it
+//
+// When c.Refresh is set the resource is always requested again, conditionally when the cached copy has an ETag or
+// Last-Modified value
+func (c *Client) fetch(key CacheKey, path string) ([]byte, error) {
+	if c.Cache == nil {
+		return c.Get(path)
+	}
+
+	cached, err := c.Cache.Load(key)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	if cached != nil && !c.Refresh {
+		return cached.Body, nil
+	}
+
+	header := http.Header{}
+	if cached != nil {
+		if cached.ETag != "" {
+			header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
+
+	resp, err := c.do(http.MethodGet, path, nil, header)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &CacheEntry{
+		URL:          resp.url,
+		ETag:         resp.header.Get("ETag"),
+		LastModified: resp.header.Get("Last-Modified"),
+		FetchedAt:    time.Now(),
+		Body:         resp.body,
+	}
+	if resp.status == http.StatusNotModified {
+		entry.Body = cached.Body
+		if entry.ETag == "" {
+			entry.ETag = cached.ETag
+		}
+		if entry.LastModified == "" {
+			entry.LastModified = cached.LastModified
+		}
+	}
+
+	if err = c.Cache.Store(key, entry); err != nil {
+		return nil, err
+	}
+
+	return entry.Body, nil
+}
@@ -0,0 +1,90 @@
+package advent_of_code
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClientCachesDownloads(t *testing.T) {
+	requests := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write([]byte("1abc2\n"))
+	})
+	client.Cache = &Cache{Dir: t.TempDir()}
+
+	for i := 0; i < 2; i++ {
+		body, err := client.Input(1, 2023)
+		if err != nil {
+			t.Fatalf("Input() error = %v", err)
+		}
+		if string(body) != "1abc2\n" {
+			t.Fatalf("Input() = %q, want %q", body, "1abc2\n")
+		}
+	}
+	if requests != 1 {
+		t.Errorf("requests = %d, want 1", requests)
+	}
+}
+
+func TestClientRevalidatesCachedDownloads(t *testing.T) {
+	const etag = `"v1"`
+	const lastModified = "Fri, 01 Dec 2023 05:00:00 GMT"
+
+	var revalidations int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == etag && r.Header.Get("If-Modified-Since") == lastModified {
+			revalidations++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Last-Modified", lastModified)
+		_, _ = w.Write([]byte("1abc2\n"))
+	})
+	client.Cache = &Cache{Dir: t.TempDir()}
+
+	if _, err := client.Input(1, 2023); err != nil {
+		t.Fatalf("Input() error = %v", err)
+	}
+
+	client.Refresh = true
+	body, err := client.Input(1, 2023)
+	if err != nil {
+		t.Fatalf("Input() with Refresh error = %v", err)
+	}
+	if string(body) != "1abc2\n" {
+		t.Errorf("Input() with Refresh = %q, want the cached %q", body, "1abc2\n")
+	}
+	if revalidations != 1 {
+		t.Fatalf("revalidations = %d, want 1", revalidations)
+	}
+
+	// the 304 keeps the validators, so the copy can be revalidated again
+	entry, err := client.Cache.Load(CacheKey{Year: 2023, Day: 1, Resource: CacheInput})
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if entry.ETag != etag || entry.LastModified != lastModified || string(entry.Body) != "1abc2\n" {
+		t.Errorf("cached entry = %+v, want the original validators and body", entry)
+	}
+	if _, err = client.Input(1, 2023); err != nil || revalidations != 2 {
+		t.Errorf("second revalidation: error = %v, revalidations = %d, want 2", err, revalidations)
+	}
+}
+
+func TestSessionCacheDir(t *testing.T) {
+	dir := t.TempDir()
+
+	alice, bob := SessionCacheDir(dir, "alice-session"), SessionCacheDir(dir, "bob-session")
+	if alice == bob || alice == dir {
+		t.Errorf("SessionCacheDir() = %q and %q, want a separate directory for each session", alice, bob)
+	}
+	if again := SessionCacheDir(dir, "alice-session"); again != alice {
+		t.Errorf("SessionCacheDir() = %q then %q, want the same directory for the same session", alice, again)
+	}
+	if strings.Contains(alice, "alice-session") {
+		t.Errorf("SessionCacheDir() = %q contains the session", alice)
+	}
+}
@@ -0,0 +1,156 @@
+package advent_of_code
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+)
+
+// FirstYear is the year of the first Advent of Code event
+const FirstYear = 2015
+
+const (
+	unlockRetryDelay    = time.Second
+	unlockRetryMaxDelay = 30 * time.Second
+	unlockRetryAttempts = 10
+)
+
+// EventLocation returns the ET timezone that puzzles unlock in
+func EventLocation() *time.Location {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		log.Fatalf("Error loading timezone: %v", err)
+	}
+
+	return loc
+}
+
+// UnlockTime returns the instant the puzzle for the given day and year unlocks: midnight ET
+func UnlockTime(day, year int) time.Time {
+	return time.Date(year, time.December, day, 0, 0, 0, 0, EventLocation())
+}
+
+// LastYear returns the year of the most recent event: the current ET year once December has started
+func LastYear(now time.Time) int {
+	now = now.In(EventLocation())
+	if now.Month() == time.December {
+		return now.Year()
+	}
+
+	return now.Year() - 1
+}
+
+// DaysInYear returns the number of puzzles in the event for the given year
+func DaysInYear(year int) int {
+	// events were shortened to 12 days starting in 2025
+	if year >= 2025 {
+		return 12
+	}
+
+	return 25
+}
+
+// Unlocked reports whether the puzzle for the given day and year has unlocked as of now
+func Unlocked(day, year int, now time.Time) bool {
+	return !now.Before(UnlockTime(day, year))
+}
+
+// ValidateDay checks that the given day and year name a puzzle in a real event
+func ValidateDay(day, year int, now time.Time) error {
+	if lastYear := LastYear(now); year < FirstYear || year > lastYear {
+		return fmt.Errorf("invalid year: %d; events run from %d to %d", year, FirstYear, lastYear)
+	}
+
+	if days := DaysInYear(year); day < 1 || day > days {
+		return fmt.Errorf("invalid day of the month: %d; the %d event has days 1 to %d", day, year, days)
+	}
+
+	return nil
+}
+
+// ValidateUnlocked checks that the given day and year name a puzzle that has already unlocked
+func ValidateUnlocked(day, year int, now time.Time) error {
+	if err := ValidateDay(day, year, now); err != nil {
+		return err
+	}
+
+	if !Unlocked(day, year, now) {
+		return fmt.Errorf("%w: day %d of %d unlocks at %s", ErrNotUnlocked, day, year,
+			UnlockTime(day, year).Format(time.RFC1123))
+	}
+
+	return nil
+}
+
+// ValidateUpcomingDay is ValidateDay that also accepts the days of this year's event before December has started, so
+// that the first puzzles can be waited for
+func ValidateUpcomingDay(day, year int, now time.Time) error {
+	if year != now.In(EventLocation()).Year() || year <= LastYear(now) {
+		return ValidateDay(day, year, now)
+	}
+
+	if days := DaysInYear(year); day < 1 || day > days {
+		return fmt.Errorf("invalid day of the month: %d; the %d event has days 1 to %d", day, year, days)
+	}
+
+	return nil
+}
+
+// checkUnlocked returns ErrNotUnlocked for puzzles that have not unlocked yet, so no request is wasted on them
+func checkUnlocked(day, year int) error {
+	if !Unlocked(day, year, time.Now()) {
+		return fmt.Errorf("%w: day %d of %d unlocks at %s", ErrNotUnlocked, day, year,
+			UnlockTime(day, year).Format(time.RFC1123))
+	}
+
+	return nil
+}
+
+// WaitForUnlock blocks until the puzzle for the given day and year unlocks, showing a countdown while it waits
+func WaitForUnlock(day, year int) {
+	WaitUntil(UnlockTime(day, year), fmt.Sprintf("Day %d of %d unlocks", day, year))
+}
+
+// WaitUntil blocks until the deadline, showing a countdown prefixed with the label while it waits
+func WaitUntil(deadline time.Time, label string) {
+	if !time.Now().Before(deadline) {
+		return
+	}
+
+	for remaining := time.Until(deadline); remaining > 0; remaining = time.Until(deadline) {
+		fmt.Printf("\r%s in %s ", label, formatCountdown(remaining))
+		time.Sleep(min(remaining, time.Second))
+	}
+
+	fmt.Printf("\r%s now%20s\n", label, "")
+}
+
+// RetryUntilUnlocked calls fn until it stops failing with ErrNotUnlocked, backing off between attempts
+//
+// The site clock and the local clock rarely agree to the second, so the first requests right after the unlock may
+// still be told that the puzzle is not available
+func RetryUntilUnlocked(fn func() error) error {
+	delay := unlockRetryDelay
+
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || !errors.Is(err, ErrNotUnlocked) || attempt == unlockRetryAttempts {
+			return err
+		}
+
+		fmt.Printf("Puzzle is not available yet; retrying in %s\n", delay)
+		time.Sleep(delay)
+		delay = min(delay*2, unlockRetryMaxDelay)
+	}
+}
+
+func formatCountdown(d time.Duration) string {
+	d = d.Round(time.Second)
+	hours := d / time.Hour
+	d -= hours * time.Hour
+	minutes := d / time.Minute
+	d -= minutes * time.Minute
+
+	return fmt.Sprintf("%02d:%02d:%02d", hours, minutes, d/time.Second)
+}
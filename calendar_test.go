@@ -0,0 +1,49 @@
+package advent_of_code
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestValidateUpcomingDay(t *testing.T) {
+	// a minute before the 2026 event starts
+	now := time.Date(2026, time.November, 30, 23, 59, 0, 0, EventLocation())
+
+	tests := []struct {
+		name    string
+		day     int
+		year    int
+		wantErr bool
+	}{
+		{name: "first day of the upcoming event", day: 1, year: 2026},
+		{name: "last day of the upcoming event", day: 12, year: 2026},
+		{name: "past the last day of the upcoming event", day: 13, year: 2026, wantErr: true},
+		{name: "last year's event", day: 12, year: 2025},
+		{name: "past the last day of last year's event", day: 25, year: 2025, wantErr: true},
+		{name: "an earlier event", day: 25, year: 2024},
+		{name: "next year's event", day: 1, year: 2027, wantErr: true},
+		{name: "before the first event", day: 1, year: 2014, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateUpcomingDay(tt.day, tt.year, now); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateUpcomingDay() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateUnlocked(t *testing.T) {
+	now := time.Date(2025, time.December, 5, 12, 0, 0, 0, EventLocation())
+
+	if err := ValidateUnlocked(5, 2025, now); err != nil {
+		t.Errorf("ValidateUnlocked() for an unlocked day error = %v", err)
+	}
+	if err := ValidateUnlocked(6, 2025, now); !errors.Is(err, ErrNotUnlocked) {
+		t.Errorf("ValidateUnlocked() for a locked day error = %v, want %v", err, ErrNotUnlocked)
+	}
+	if err := ValidateUnlocked(13, 2025, now); err == nil || errors.Is(err, ErrNotUnlocked) {
+		t.Errorf("ValidateUnlocked() for a day outside the event error = %v, want an invalid day", err)
+	}
+}
@@ -0,0 +1,313 @@
+package advent_of_code
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultBaseURL = "https://adventofcode.com"
+	DefaultTimeout = 5 * time.Second
+)
+
+var (
+	ErrBadRequest       = errors.New("bad request")
+	ErrNotFound         = errors.New("not found")
+	ErrUnauthenticated  = errors.New("not logged in; check the session cookie")
+	ErrNotUnlocked      = errors.New("puzzle has not unlocked yet")
+	ErrRateLimited      = errors.New("rate limited by adventofcode.com")
+	ErrServerError      = errors.New("server error")
+	ErrUnexpectedStatus = errors.New("unexpected status")
+	ErrMissingSession   = errors.New("no session available")
+	ErrMissingUserAgent = errors.New("no user agent set; AoC asks automated tools to identify themselves")
+)
+
+// known response bodies that adventofcode.com sends instead of the requested page
+var (
+	unauthenticatedBodies = [][]byte{
+		[]byte("please log in"),
+		[]byte("please identify yourself"),
+	}
+	notUnlockedBodies = [][]byte{
+		[]byte("please don't repeatedly request this endpoint before it unlocks"),
+	}
+	rateLimitedBodies = [][]byte{
+		[]byte("too many requests"),
+	}
+)
+
+// ResponseError is returned when adventofcode.com replies with something other than the requested page
+type ResponseError struct {
+	StatusCode int
+	Err        error
+	Body       []byte
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("%v (status %d)", e.Err, e.StatusCode)
+}
+
+func (e *ResponseError) Unwrap() error {
+	return e.Err
+}
+
+// SessionSource provides the session cookie value used to authenticate requests
+type SessionSource func() (string, error)
+
+// EnvSession reads the session cookie value from the named environment variable
+func EnvSession(name string) SessionSource {
+	return func() (string, error) {
+		session := os.Getenv(name)
+		if session == "" {
+			return "", fmt.Errorf("%w: %s environment variable is not set", ErrMissingSession, name)
+		}
+		return session, nil
+	}
+}
+
+// StaticSession always provides the given session cookie value
+func StaticSession(session string) SessionSource {
+	return func() (string, error) {
+		return session, nil
+	}
+}
+
+// Client talks to adventofcode.com (or anything pretending to be it)
+type Client struct {
+	BaseURL   string
+	Session   SessionSource
+	Timeout   time.Duration
+	UserAgent string
+	Transport http.RoundTripper
+	// Throttle, when set, spaces out requests
+	Throttle *Throttle
+	// Cache, when set, keeps inputs and puzzle pages so they are only downloaded once
+	Cache *Cache
+	// Refresh forces cached resources to be requested again
+	Refresh bool
+}
+
+// NewClient returns a client for the profile configured from the environment
+//
+// The profile's session variable (see Profile.SessionEnv) provides the session cookie and AOC_USER_AGENT the
+// User-Agent header, which should identify the tool and its owner. AOC_URL may be used to point the client at another
+// server and AOC_CACHE_DIR may be used to move the cache and the throttle state. Every profile has its own cache, but
+// they share the throttle, as requests for all of them come from the same machine
+func NewClient(profile Profile) (*Client, error) {
+	userAgent := os.Getenv("AOC_USER_AGENT")
+	if userAgent == "" {
+		return nil, fmt.Errorf("%w: AOC_USER_AGENT environment variable is not set", ErrMissingUserAgent)
+	}
+
+	baseURL := os.Getenv("AOC_URL")
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+
+	throttleDir, err := DefaultCacheDir()
+	if err != nil {
+		return nil, err
+	}
+	cacheDir, err := profile.CacheDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		BaseURL:   baseURL,
+		Session:   EnvSession(profile.SessionEnv()),
+		Timeout:   DefaultTimeout,
+		UserAgent: userAgent,
+		Throttle: &Throttle{
+			Path:     filepath.Join(throttleDir, "throttle"),
+			Interval: DefaultThrottleInterval,
+		},
+		Cache: &Cache{Dir: cacheDir},
+	}, nil
+}
+
+// Input fetches the puzzle input for the given day and year
+func (c *Client) Input(day, year int) ([]byte, error) {
+	if err := checkUnlocked(day, year); err != nil {
+		return nil, err
+	}
+
+	return c.fetch(CacheKey{Year: year, Day: day, Resource: CacheInput}, fmt.Sprintf("/%d/day/%d/input", year, day))
+}
+
+// Puzzle fetches the puzzle page for the given day and year
+func (c *Client) Puzzle(day, year int) ([]byte, error) {
+	if err := checkUnlocked(day, year); err != nil {
+		return nil, err
+	}
+
+	body, err := c.fetch(CacheKey{Year: year, Day: day, Resource: CachePuzzle}, fmt.Sprintf("/%d/day/%d", year, day))
+	// the puzzle page is a plain 404 until the day unlocks
+	var respErr *ResponseError
+	if errors.As(err, &respErr) && respErr.Err == ErrNotFound {
+		respErr.Err = ErrNotUnlocked
+	}
+
+	return body, err
+}
+
+// Answer posts an answer for the given day, year and level and returns the reply page
+func (c *Client) Answer(day, year, level int, answer string) ([]byte, error) {
+	if err := checkUnlocked(day, year); err != nil {
+		return nil, err
+	}
+
+	return c.Post(fmt.Sprintf("/%d/day/%d/answer", year, day), url.Values{
+		"level":  {fmt.Sprintf("%d", level)},
+		"answer": {answer},
+	})
+}
+
+func (c *Client) Get(path string) ([]byte, error) {
+	resp, err := c.do(http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.body, nil
+}
+
+func (c *Client) Post(path string, form url.Values) ([]byte, error) {
+	header := http.Header{}
+	header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.do(http.MethodPost, path, strings.NewReader(form.Encode()), header)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.body, nil
+}
+
+type response struct {
+	url    string
+	status int
+	header http.Header
+	body   []byte
+}
+
+func (c *Client) do(method, path string, body io.Reader, header http.Header) (*response, error) {
+	req, err := http.NewRequest(method, strings.TrimRight(c.BaseURL, "/")+path, body)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	if c.Session == nil {
+		return nil, ErrMissingSession
+	}
+	session, err := c.Session()
+	if err != nil {
+		return nil, err
+	}
+
+	if c.UserAgent == "" {
+		return nil, ErrMissingUserAgent
+	}
+
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	req.AddCookie(&http.Cookie{Name: "session", Value: session})
+	req.Header.Set("User-Agent", c.UserAgent)
+
+	if c.Throttle != nil {
+		if err = c.Throttle.Wait(); err != nil {
+			return nil, err
+		}
+	}
+
+	httpClient := &http.Client{
+		Timeout:   c.Timeout,
+		Transport: c.Transport,
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	contents, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response: %w", err)
+	}
+
+	if err := checkResponse(resp.StatusCode, contents); err != nil {
+		return nil, err
+	}
+
+	return &response{
+		url:    req.URL.String(),
+		status: resp.StatusCode,
+		header: resp.Header,
+		body:   contents,
+	}, nil
+}
+
+// checkResponse turns error statuses and the known AoC error pages into errors
+func checkResponse(status int, body []byte) error {
+	kind := classifyResponse(status, body)
+	if kind == nil {
+		return nil
+	}
+
+	return &ResponseError{
+		StatusCode: status,
+		Err:        kind,
+		Body:       body,
+	}
+}
+
+func classifyResponse(status int, body []byte) error {
+	lower := bytes.ToLower(body)
+
+	// the body is checked first; AoC sends some of these with a 200
+	switch {
+	case containsAny(lower, unauthenticatedBodies):
+		return ErrUnauthenticated
+	case containsAny(lower, notUnlockedBodies):
+		return ErrNotUnlocked
+	case containsAny(lower, rateLimitedBodies):
+		return ErrRateLimited
+	}
+
+	switch {
+	case status >= 200 && status < 300, status == http.StatusNotModified:
+		return nil
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return ErrUnauthenticated
+	case status == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case status == http.StatusBadRequest:
+		return ErrBadRequest
+	case status == http.StatusNotFound:
+		return ErrNotFound
+	case status >= 500:
+		return ErrServerError
+	default:
+		return ErrUnexpectedStatus
+	}
+}
+
+func containsAny(body []byte, needles [][]byte) bool {
+	for _, needle := range needles {
+		if bytes.Contains(body, needle) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+package advent_of_code
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newTestClient returns a client for a local server that answers every request with handler
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return &Client{
+		BaseURL:   server.URL,
+		Session:   StaticSession("test-session"),
+		Timeout:   DefaultTimeout,
+		UserAgent: "advent-of-code tests",
+	}
+}
+
+func TestClientIdentifiesItself(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if cookie, err := r.Cookie("session"); err != nil || cookie.Value != "test-session" {
+			t.Errorf("session cookie = %v, want test-session", cookie)
+		}
+		if got := r.Header.Get("User-Agent"); got != "advent-of-code tests" {
+			t.Errorf("User-Agent = %q, want %q", got, "advent-of-code tests")
+		}
+		if r.URL.Path != "/2023/day/1/input" {
+			t.Errorf("path = %s, want /2023/day/1/input", r.URL.Path)
+		}
+		_, _ = w.Write([]byte("1abc2\n"))
+	})
+
+	body, err := client.Input(1, 2023)
+	if err != nil {
+		t.Fatalf("Input() error = %v", err)
+	}
+	if string(body) != "1abc2\n" {
+		t.Errorf("Input() = %q, want %q", body, "1abc2\n")
+	}
+}
+
+func TestClientPostsAnswers(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/2023/day/1/answer" {
+			t.Errorf("request = %s %s, want POST /2023/day/1/answer", r.Method, r.URL.Path)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("ParseForm() error = %v", err)
+		}
+		if r.PostForm.Get("level") != "2" || r.PostForm.Get("answer") != "281" {
+			t.Errorf("form = %v, want level 2 and answer 281", r.PostForm)
+		}
+		_, _ = w.Write([]byte("<main><article><p>That's the right answer!</p></article></main>"))
+	})
+
+	if _, err := client.Answer(1, 2023, 2, "281"); err != nil {
+		t.Fatalf("Answer() error = %v", err)
+	}
+}
+
+func TestClientRequiresSessionAndUserAgent(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request should be sent")
+	})
+
+	client.Session = EnvSession("AOC_TEST_MISSING_SESSION")
+	if _, err := client.Get("/"); !errors.Is(err, ErrMissingSession) {
+		t.Errorf("Get() without a session error = %v, want %v", err, ErrMissingSession)
+	}
+
+	client.Session = StaticSession("test-session")
+	client.UserAgent = ""
+	if _, err := client.Get("/"); !errors.Is(err, ErrMissingUserAgent) {
+		t.Errorf("Get() without a user agent error = %v, want %v", err, ErrMissingUserAgent)
+	}
+}
+
+func TestClientClassifiesResponses(t *testing.T) {
+	tests := map[string]struct {
+		status int
+		body   string
+		want   error
+	}{
+		"ok":                  {status: http.StatusOK, body: "1abc2\n"},
+		"log in with 400":     {status: http.StatusBadRequest, body: "Puzzle inputs differ by user.  Please log in to get your puzzle input.", want: ErrUnauthenticated},
+		"log in with 200":     {status: http.StatusOK, body: "Puzzle inputs differ by user.  Please log in to get your puzzle input.", want: ErrUnauthenticated},
+		"identify yourself":   {status: http.StatusOK, body: "Please identify yourself via your browser or session cookie.", want: ErrUnauthenticated},
+		"before unlock":       {status: http.StatusNotFound, body: "Please don't repeatedly request this endpoint before it unlocks!", want: ErrNotUnlocked},
+		"too many requests":   {status: http.StatusOK, body: "Too Many Requests", want: ErrRateLimited},
+		"bad request":         {status: http.StatusBadRequest, body: "400 Bad Request", want: ErrBadRequest},
+		"unauthorized":        {status: http.StatusUnauthorized, want: ErrUnauthenticated},
+		"forbidden":           {status: http.StatusForbidden, want: ErrUnauthenticated},
+		"rate limited":        {status: http.StatusTooManyRequests, want: ErrRateLimited},
+		"not found":           {status: http.StatusNotFound, body: "404 Not Found", want: ErrNotFound},
+		"server error":        {status: http.StatusInternalServerError, body: "500 Internal Server Error", want: ErrServerError},
+		"bad gateway":         {status: http.StatusBadGateway, want: ErrServerError},
+		"unexpected redirect": {status: http.StatusMultipleChoices, want: ErrUnexpectedStatus},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			})
+
+			_, err := client.Input(1, 2023)
+			if tc.want == nil {
+				if err != nil {
+					t.Fatalf("Input() error = %v, want none", err)
+				}
+				return
+			}
+			if !errors.Is(err, tc.want) {
+				t.Fatalf("Input() error = %v, want %v", err, tc.want)
+			}
+
+			var respErr *ResponseError
+			if !errors.As(err, &respErr) || respErr.StatusCode != tc.status || string(respErr.Body) != tc.body {
+				t.Errorf("Input() error = %#v, want a ResponseError with status %d and the body", err, tc.status)
+			}
+		})
+	}
+}
+
+func TestClientPuzzleNotFoundIsNotUnlocked(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	})
+
+	if _, err := client.Puzzle(1, 2023); !errors.Is(err, ErrNotUnlocked) {
+		t.Errorf("Puzzle() error = %v, want %v", err, ErrNotUnlocked)
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	. "github.com/stackus/advent-of-code"
+)
+
+func runFetch(a *app, args []string) {
+	var refresh, wait, inputOnly, puzzleOnly bool
+	a.parse("fetch", args, func(fs *flag.FlagSet) {
+		fs.BoolVar(&refresh, "refresh", false, "download again even when a cached copy exists")
+		fs.BoolVar(&wait, "wait", false, "wait for the puzzle to unlock, then download it")
+		fs.BoolVar(&inputOnly, "input", false, "only download the puzzle input")
+		fs.BoolVar(&puzzleOnly, "puzzle", false, "only download the puzzle description")
+	})
+	// a puzzle that is waited for doesn't need to be unlocked yet
+	if wait {
+		a.validateUpcoming()
+	} else {
+		a.validate()
+	}
+
+	client := a.client()
+	client.Refresh = refresh
+
+	adventOfCodePath := a.puzzlePath()
+	err := os.MkdirAll(adventOfCodePath, 0755)
+	if err != nil {
+		log.Fatalf("Error creating directory: %v", err)
+	}
+
+	if wait {
+		WaitForUnlock(a.day, a.year)
+	}
+	fetch := func(fn func() error) error {
+		if wait {
+			return RetryUntilUnlocked(fn)
+		}
+		return fn()
+	}
+
+	if !puzzleOnly {
+		// Get the puzzle input for the Advent of Code website for the given day and year
+		err = fetch(func() error {
+			return writeInput(client, a.day, a.year, a.profilePath())
+		})
+		if err != nil {
+			log.Fatalf("Error getting puzzle input: %v", err)
+		}
+		fmt.Println("Puzzle input written for day", a.day, "and year", a.year)
+	}
+
+	if !inputOnly {
+		// Get the puzzle description and examples for the given day and year
+		err = fetch(func() error {
+			return WritePuzzle(client, a.day, a.year, adventOfCodePath, a.profilePath())
+		})
+		if err != nil {
+			log.Fatalf("Error getting puzzle: %v", err)
+		}
+		fmt.Println("Puzzle description written for day", a.day, "and year", a.year)
+	}
+}
+
+// writeInput downloads the input into the directory of the profile the client belongs to
+func writeInput(client *Client, day, year int, profilePath string) error {
+	body, err := client.Input(day, year)
+	if err != nil {
+		return err
+	}
+
+	if len(body) == 0 {
+		return fmt.Errorf("received an empty input")
+	}
+
+	return WriteFile(filepath.Join(profilePath, "input.txt"), body, true)
+}
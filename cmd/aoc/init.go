@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	. "github.com/stackus/advent-of-code"
+)
+
+//go:embed embeds/*
+var embeds embed.FS
+
+func runInit(a *app, args []string) {
+	a.parse("init", args, nil)
+	a.validate()
+
+	adventOfCodePath := a.puzzlePath()
+	err := os.MkdirAll(adventOfCodePath, 0755)
+	if err != nil {
+		log.Fatalf("Error creating directory: %v", err)
+	}
+
+	t, err := template.ParseFS(embeds, "embeds/*.tmpl")
+	if err != nil {
+		log.Fatalf("Error parsing embeds directory: %s", err)
+	}
+
+	// list of files to create; each is rendered from the template with the same name plus .tmpl
+	files := []string{
+		"main.go",
+		"main_test.go",
+	}
+	for _, file := range files {
+		// skip files that already exist so that days initialised earlier can pick up new files
+		filePath := filepath.Join(adventOfCodePath, file)
+		if _, err := os.Stat(filePath); err == nil {
+			fmt.Println("Skipping", file, "as it already exists")
+			continue
+		}
+
+		buf := bytes.Buffer{}
+		err = t.ExecuteTemplate(&buf, file+".tmpl", struct {
+			Day  int
+			Year int
+		}{
+			Day:  a.day,
+			Year: a.year,
+		})
+		if err != nil {
+			log.Fatalf("Error executing template: %v", err)
+		}
+		err = WriteFile(filePath, buf.Bytes(), false)
+		if err != nil {
+			log.Fatalf("Error writing file: %v", err)
+		}
+	}
+
+	fmt.Println("Initialised day", a.day, "for year", a.year)
+}
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/stackus/advent-of-code"
+)
+
+// exit codes shared by every command; submit adds one per verdict
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+type command struct {
+	name    string
+	args    string
+	summary string
+	run     func(a *app, args []string)
+}
+
+var commands []*command
+
+func init() {
+	commands = []*command{
+		{name: "init", summary: "create the puzzle directory with a solution and test template", run: runInit},
+		{name: "fetch", summary: "download the puzzle input and description", run: runFetch},
+		{name: "run", args: "[-- solution flags]", summary: "run the solution for a puzzle", run: runRun},
+		{name: "test", summary: "run the example tests and check the accepted answers", run: runTest},
+		{name: "submit", summary: "submit the solution for a puzzle", run: runSubmit},
+		{name: "status", summary: "show the stars and local state of each puzzle", run: runStatus},
+	}
+}
+
+// app holds the global flags, which every command accepts
+type app struct {
+	day     int
+	year    int
+	session string
+	root    string
+	// profileName is the -profile flag; profile is resolved from it once .env.local has been loaded
+	profileName string
+	profile     Profile
+	// explicit holds the names of the flags given on the command line, before or after the command name
+	explicit map[string]bool
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("aoc: ")
+
+	a := &app{explicit: map[string]bool{}}
+	globals := a.flags("aoc", "<command> [flags]")
+	_ = globals.Parse(os.Args[1:])
+	globals.Visit(a.markExplicit)
+
+	if globals.NArg() == 0 {
+		globals.Usage()
+		os.Exit(exitUsage)
+	}
+
+	name := globals.Arg(0)
+	for _, cmd := range commands {
+		if cmd.name == name {
+			cmd.run(a, globals.Args()[1:])
+			os.Exit(exitOK)
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "aoc: unknown command %q\n", name)
+	globals.Usage()
+	os.Exit(exitUsage)
+}
+
+// flags returns a flag set for the named command with the global flags already registered
+func (a *app) flags(name, args string) *flag.FlagSet {
+	// use ET timezone
+	now := time.Now().In(EventLocation())
+
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	fs.IntVar(&a.day, "day", now.Day(), "day of the month")
+	fs.IntVar(&a.year, "year", LastYear(now), "year")
+	fs.StringVar(&a.session, "session", "", "session cookie to use instead of the one of the profile")
+	fs.StringVar(&a.profileName, "profile", "", "account profile to use (default: AOC_PROFILE, or the default profile)")
+	// a missing root is only an error once a command needs it, so that -h and -root still work anywhere
+	root, _ := FindRoot()
+	fs.StringVar(&a.root, "root", root, "repository root holding the <year>/day-<day> puzzle directories; found from AOC_ROOT or the working directory")
+
+	fs.Usage = func() {
+		out := fs.Output()
+		if name == "aoc" {
+			fmt.Fprintf(out, "Usage: aoc %s\n\nCommands:\n", args)
+			for _, cmd := range commands {
+				fmt.Fprintf(out, "  %-8s %s\n", cmd.name, cmd.summary)
+			}
+			fmt.Fprintf(out, "\nRun 'aoc <command> -h' for the flags of a command.\n\nGlobal flags:\n")
+		} else {
+			fmt.Fprintf(out, "Usage: aoc %s [flags] %s\n\nFlags:\n", name, args)
+		}
+		fs.PrintDefaults()
+	}
+
+	return fs
+}
+
+// parse parses the flags of the named command, allowing the global flags to be given after the command name as well
+//
+// setup registers the flags of the command itself; .env.local is loaded once the root is known
+func (a *app) parse(name string, args []string, setup func(fs *flag.FlagSet)) *flag.FlagSet {
+	usage := ""
+	for _, cmd := range commands {
+		if cmd.name == name {
+			usage = cmd.args
+		}
+	}
+
+	// keep the values of global flags given before the command name
+	day, year, session, root, profileName := a.day, a.year, a.session, a.root, a.profileName
+
+	fs := a.flags(name, usage)
+	a.day, a.year, a.session, a.root, a.profileName = day, year, session, root, profileName
+	if setup != nil {
+		setup(fs)
+	}
+	_ = fs.Parse(args)
+	fs.Visit(a.markExplicit)
+
+	if a.root == "" {
+		log.Printf("Error finding root: %v", ErrRootNotFound)
+		log.Printf("Run aoc from inside the repository, set AOC_ROOT, or pass -root")
+		os.Exit(exitUsage)
+	}
+	root, err := filepath.Abs(a.root)
+	if err != nil {
+		log.Fatalf("Error resolving root: %v", err)
+	}
+	a.root = root
+
+	a.loadEnv()
+
+	if a.profileName == "" {
+		a.profileName = os.Getenv("AOC_PROFILE")
+	}
+	if a.profile, err = ParseProfile(a.profileName); err != nil {
+		log.Printf("Invalid profile: %v", err)
+		os.Exit(exitUsage)
+	}
+
+	return fs
+}
+
+func (a *app) markExplicit(f *flag.Flag) {
+	a.explicit[f.Name] = true
+}
+
+// validate checks that the day and year flags name a puzzle in a real event that has already unlocked
+func (a *app) validate() {
+	a.exitInvalid(ValidateUnlocked(a.day, a.year, time.Now()))
+}
+
+// validateUpcoming checks that the day and year flags name a puzzle in a real event, or in this year's event before
+// it starts; the puzzle may still be locked
+func (a *app) validateUpcoming() {
+	a.exitInvalid(ValidateUpcomingDay(a.day, a.year, time.Now()))
+}
+
+func (a *app) exitInvalid(err error) {
+	if err != nil {
+		log.Printf("Invalid puzzle: %v", err)
+		os.Exit(exitUsage)
+	}
+}
+
+// loadEnv reads .env.local from the root so that the commands work without Taskfile loading it for them
+func (a *app) loadEnv() {
+	if err := LoadEnvFile(filepath.Join(a.root, ".env.local")); err != nil && !os.IsNotExist(err) {
+		log.Fatalf("Error loading .env.local: %v", err)
+	}
+}
+
+// puzzlePath returns the directory of the selected puzzle
+func (a *app) puzzlePath() string {
+	return PuzzlePath(a.root, a.day, a.year)
+}
+
+// profilePath returns the directory holding the files of the selected profile for the selected puzzle
+func (a *app) profilePath() string {
+	return a.profile.Dir(a.puzzlePath())
+}
+
+// client returns a client for adventofcode.com for the selected profile that uses the -session flag when it was given
+func (a *app) client() *Client {
+	client, err := NewClient(a.profile)
+	if err != nil {
+		log.Fatalf("Error creating client: %v", err)
+	}
+
+	if session := strings.TrimSpace(a.session); session != "" {
+		client.Session = StaticSession(session)
+		// another account's downloads must not be mixed up with the ones cached for the profile
+		client.Cache = &Cache{Dir: SessionCacheDir(client.Cache.Dir, session)}
+	}
+
+	return client
+}
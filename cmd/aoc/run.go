@@ -0,0 +1,88 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+
+	. "github.com/stackus/advent-of-code"
+)
+
+func runRun(a *app, args []string) {
+	var puzzle int
+	var allProfiles bool
+	fs := a.parse("run", args, func(fs *flag.FlagSet) {
+		fs.IntVar(&puzzle, "puzzle", 1, "puzzle number: 1 or 2")
+		fs.BoolVar(&allProfiles, "all-profiles", false, "run on the input of every profile and compare with the accepted answers")
+	})
+	a.validate()
+
+	if allProfiles {
+		runAllProfiles(a, puzzle)
+		return
+	}
+
+	// any arguments left over are handed to the solution
+	solutionArgs := append([]string{"-puzzle", fmt.Sprintf("%d", puzzle), "-profile", string(a.profile)}, fs.Args()...)
+
+	cmd := solutionCommand(a.root, PuzzleDay{Day: a.day, Year: a.year}, solutionArgs...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.ExitCode())
+		}
+		log.Fatalf("Error running solution: %v", err)
+	}
+}
+
+// runAllProfiles runs the solution on the input of every profile, checking the solutions against the answers that AoC
+// accepted for each of them
+func runAllProfiles(a *app, puzzle int) {
+	puzzleDay := PuzzleDay{Day: a.day, Year: a.year, Path: a.puzzlePath()}
+
+	profiles, err := FindProfiles(puzzleDay.Path)
+	if err != nil {
+		log.Fatalf("Error finding profiles: %v", err)
+	}
+	if len(profiles) == 0 {
+		log.Fatalf("No profile has an input for %s; download one with 'aoc fetch'", puzzleDay)
+	}
+
+	failures := 0
+	for _, profile := range profiles {
+		ledger, err := LoadLedger(profile.Dir(puzzleDay.Path))
+		if err != nil {
+			log.Fatalf("Error loading ledger for %s: %v", profile, err)
+		}
+
+		accepted, _ := ledger.Accepted(puzzle)
+		if !checkSolution(a.root, puzzleDay, profile, puzzle, accepted) {
+			failures++
+		}
+	}
+
+	if failures > 0 {
+		log.Fatalf("%d of %d profile(s) failed", failures, len(profiles))
+	}
+}
+
+// solutionCommand returns the command that runs the solution for the day with the given arguments
+func solutionCommand(root string, puzzleDay PuzzleDay, args ...string) *exec.Cmd {
+	cmd := exec.Command("go", append([]string{"run", "./" + puzzleDay.String()}, args...)...)
+	cmd.Dir = root
+	cmd.Env = rootEnv(root)
+	cmd.Stdin = os.Stdin
+
+	return cmd
+}
+
+// rootEnv returns the environment for commands run in root, pointing the solutions at the same root
+func rootEnv(root string) []string {
+	return append(os.Environ(), "AOC_ROOT="+root)
+}
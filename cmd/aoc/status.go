@@ -0,0 +1,94 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	. "github.com/stackus/advent-of-code"
+)
+
+// runStatus shows the stars earned for each puzzle directory along with what is available locally
+func runStatus(a *app, args []string) {
+	var all bool
+	a.parse("status", args, func(fs *flag.FlagSet) {
+		fs.BoolVar(&all, "all", false, "show every year instead of only the selected one")
+	})
+
+	days, err := FindPuzzleDays(a.root)
+	if err != nil {
+		log.Fatalf("Error finding puzzles: %v", err)
+	}
+
+	progress, err := LoadProgress(a.profile)
+	if err != nil {
+		log.Fatalf("Error loading progress: %v", err)
+	}
+
+	if a.profile != DefaultProfile {
+		fmt.Println("Profile:", a.profile)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "PUZZLE\tSTARS\tINPUT\tEXAMPLES\tACCEPTED\tCOOLDOWN")
+
+	total := 0
+	for _, puzzleDay := range days {
+		if !all && puzzleDay.Year != a.year {
+			continue
+		}
+
+		profilePath := a.profile.Dir(puzzleDay.Path)
+		ledger, err := LoadLedger(profilePath)
+		if err != nil {
+			log.Fatalf("Error loading ledger for %s: %v", puzzleDay, err)
+		}
+
+		var accepted []string
+		for puzzle := 1; puzzle <= 2; puzzle++ {
+			if answer, ok := ledger.Accepted(puzzle); ok {
+				accepted = append(accepted, answer)
+			}
+		}
+
+		acceptedAnswers := "-"
+		if len(accepted) > 0 {
+			acceptedAnswers = strings.Join(accepted, ", ")
+		}
+
+		cooldown := "-"
+		if remaining := ledger.Cooldown(); remaining > 0 {
+			cooldown = remaining.Round(time.Second).String()
+		}
+
+		stars := progress.Get(puzzleDay.Day, puzzleDay.Year)
+		total += stars
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			puzzleDay,
+			strings.Repeat("*", stars)+strings.Repeat(".", 2-stars),
+			present(filepath.Join(profilePath, "input.txt")),
+			present(filepath.Join(puzzleDay.Path, "examples.json")),
+			acceptedAnswers,
+			cooldown,
+		)
+	}
+
+	if err = w.Flush(); err != nil {
+		log.Fatalf("Error writing status: %v", err)
+	}
+	fmt.Println("Total stars:", total)
+}
+
+func present(filename string) string {
+	if _, err := os.Stat(filename); err != nil {
+		return "no"
+	}
+
+	return "yes"
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/stackus/advent-of-code"
+)
+
+func runSubmit(a *app, args []string) {
+	var puzzle int
+	var force, wait bool
+	a.parse("submit", args, func(fs *flag.FlagSet) {
+		fs.IntVar(&puzzle, "puzzle", 1, "puzzle number: 1 or 2")
+		fs.BoolVar(&force, "force", false, "submit even when the ledger shows the answer is wrong")
+		fs.BoolVar(&wait, "wait", false, "wait out any submission cooldown, then submit")
+	})
+	a.validate()
+
+	// check puzzle is valid
+	if puzzle < 1 || puzzle > 2 {
+		log.Printf("Invalid puzzle number: %d", puzzle)
+		os.Exit(exitUsage)
+	}
+
+	// read the solution from the solution file of the profile
+	profilePath := a.profilePath()
+	answerPath := filepath.Join(profilePath, fmt.Sprintf("solution-%d.txt", puzzle))
+	contents, err := os.ReadFile(answerPath)
+	if err != nil {
+		log.Fatalf("Error reading solution: %v", err)
+	}
+
+	// trim solution of all whitespace; answers may be numbers or text
+	solution := strings.Trim(string(contents), "\n\t ")
+	if solution == "" {
+		log.Fatalf("Error reading solution: %s is empty", answerPath)
+	}
+
+	// refuse answers that the ledger can already prove wrong
+	ledger, err := LoadLedger(profilePath)
+	if err != nil {
+		log.Fatalf("Error loading ledger: %v", err)
+	}
+	if err = ledger.Check(puzzle, solution); err != nil {
+		if !force {
+			log.Fatalf("Not submitting %s: %v (use -force to submit anyway)", solution, err)
+		}
+		fmt.Println("Submitting anyway:", err)
+	}
+
+	client := a.client()
+
+	var result *SubmitResult
+	for {
+		// AoC will only reject answers sent during a cooldown, so don't send them
+		if remaining := ledger.Cooldown(); remaining > 0 {
+			if !wait {
+				log.Printf("Not submitting %s: answered too recently; wait %s (use -wait to wait automatically)",
+					solution, remaining.Round(time.Second))
+				os.Exit(VerdictRateLimited.ExitCode())
+			}
+			WaitUntil(ledger.CooldownUntil, "Submitting")
+		}
+
+		result, err = submitSolution(client, a.day, a.year, puzzle, solution)
+		if err != nil {
+			log.Fatalf("Error submitting solution: %v", err)
+		}
+		if puzzle == 2 {
+			result.ResolveLevel(partOneSolved(ledger, a.profile, a.day, a.year))
+		}
+
+		ledger.Record(puzzle, solution, result)
+		if err = ledger.Save(); err != nil {
+			log.Fatalf("Error saving ledger: %v", err)
+		}
+
+		if result.Verdict != VerdictRateLimited || result.Wait == 0 || !wait {
+			break
+		}
+		fmt.Println("Answered too recently; waiting", result.Wait, "before submitting again")
+	}
+
+	replyPath := filepath.Join(profilePath, fmt.Sprintf("reply-%d.md", puzzle))
+	err = WriteFile(replyPath, []byte(result.Message), true)
+	if err != nil {
+		log.Fatalf("Error writing reply: %v", err)
+	}
+
+	fmt.Println("Got reply:", result.Message, "\nThis reply has been saved to ", replyPath)
+
+	if result.Verdict == VerdictUnknown {
+		log.Fatalf("Error submitting solution: unable to make sense of the reply")
+	}
+	fmt.Println("Result:", result)
+
+	if result.Verdict == VerdictCorrect || result.Verdict == VerdictAlreadySolved {
+		syncProgress(client, a.profile, a.day, a.year, puzzle, a.puzzlePath(), profilePath)
+	}
+
+	os.Exit(result.Verdict.ExitCode())
+}
+
+func submitSolution(client *Client, day, year, puzzle int, answer string) (*SubmitResult, error) {
+	body, err := client.Answer(day, year, puzzle, answer)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseSubmitReply(body, puzzle)
+}
+
+// partOneSolved reports whether the ledger or the stars earned by the profile show that part one of the day is solved
+func partOneSolved(ledger *Ledger, profile Profile, day, year int) bool {
+	if _, ok := ledger.Accepted(1); ok {
+		return true
+	}
+	if _, ok := ledger.Accepted(2); ok {
+		return true
+	}
+
+	progress, err := LoadProgress(profile)
+	if err != nil {
+		log.Fatalf("Error loading progress: %v", err)
+	}
+
+	return progress.Get(day, year) >= 1
+}
+
+// syncProgress records the earned star and, after part one, fetches the puzzle again to pick up part two
+func syncProgress(client *Client, profile Profile, day, year, puzzle int, puzzlePath, profilePath string) {
+	progress, err := LoadProgress(profile)
+	if err != nil {
+		log.Fatalf("Error loading progress: %v", err)
+	}
+	progress.Earn(day, year, puzzle)
+	if err = progress.Save(); err != nil {
+		log.Fatalf("Error saving progress: %v", err)
+	}
+	fmt.Println("Stars earned for day", day, "and year", year, ":", progress.Get(day, year))
+
+	if puzzle != 1 {
+		return
+	}
+
+	// the cached puzzle page only has part one
+	client.Refresh = true
+	if err = WritePuzzle(client, day, year, puzzlePath, profilePath); err != nil {
+		log.Fatalf("Error getting part two of the puzzle: %v", err)
+	}
+	fmt.Println("Puzzle description updated with part two")
+}
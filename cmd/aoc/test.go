@@ -0,0 +1,154 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"strings"
+
+	. "github.com/stackus/advent-of-code"
+)
+
+// runTest runs the example tests of a day and checks its solutions still give the answers that AoC accepted
+func runTest(a *app, args []string) {
+	var all bool
+	a.parse("test", args, func(fs *flag.FlagSet) {
+		fs.BoolVar(&all, "all", false, "test every day under the root, or those matching -year and -day when given")
+	})
+
+	days := []PuzzleDay{{Day: a.day, Year: a.year, Path: a.puzzlePath()}}
+	if all {
+		found, err := FindPuzzleDays(a.root)
+		if err != nil {
+			log.Fatalf("Error finding puzzles: %v", err)
+		}
+
+		// -year and -day narrow down the days when they are given
+		days = days[:0]
+		for _, puzzleDay := range found {
+			if (a.explicit["year"] && puzzleDay.Year != a.year) || (a.explicit["day"] && puzzleDay.Day != a.day) {
+				continue
+			}
+			days = append(days, puzzleDay)
+		}
+	} else {
+		a.validate()
+	}
+
+	failures := 0
+	for _, puzzleDay := range days {
+		profiles, err := FindProfiles(puzzleDay.Path)
+		if err != nil {
+			log.Fatalf("Error finding profiles for %s: %v", puzzleDay, err)
+		}
+		if len(profiles) == 0 {
+			fmt.Printf("SKIP %s: input.txt is missing; download it with 'aoc fetch'\n", puzzleDay)
+			continue
+		}
+
+		if !testExamples(a.root, puzzleDay) {
+			failures++
+		}
+		for _, profile := range profiles {
+			failures += checkAccepted(a.root, puzzleDay, profile)
+		}
+	}
+
+	if failures > 0 {
+		log.Fatalf("%d check(s) failed", failures)
+	}
+}
+
+// testExamples runs the go tests of the day, which check the solutions against the examples
+func testExamples(root string, puzzleDay PuzzleDay) bool {
+	cmd := exec.Command("go", "test", "./"+puzzleDay.String())
+	cmd.Dir = root
+	cmd.Env = rootEnv(root)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	return cmd.Run() == nil
+}
+
+// checkAccepted runs each part of the day that the profile has solved and compares its solution with the answer AoC
+// accepted; it returns the number of mismatches
+func checkAccepted(root string, puzzleDay PuzzleDay, profile Profile) int {
+	ledger, err := LoadLedger(profile.Dir(puzzleDay.Path))
+	if err != nil {
+		log.Fatalf("Error loading ledger for %s: %v", puzzleDay, err)
+	}
+
+	failures, known := 0, 0
+	for puzzle := 1; puzzle <= 2; puzzle++ {
+		accepted, ok := ledger.Accepted(puzzle)
+		if !ok {
+			continue
+		}
+		known++
+		if !checkSolution(root, puzzleDay, profile, puzzle, accepted) {
+			failures++
+		}
+	}
+	if known == 0 {
+		// days solved before the ledger existed only know their answers once the puzzle page is fetched again
+		fmt.Printf("SKIP %s: no accepted answers; 'aoc fetch -puzzle -refresh' records those of solved puzzles\n",
+			checkName(puzzleDay, profile))
+	}
+
+	return failures
+}
+
+// checkName names the day, and the profile when it is not the default one, in the lines that report a check
+func checkName(puzzleDay PuzzleDay, profile Profile) string {
+	if profile == DefaultProfile {
+		return puzzleDay.String()
+	}
+
+	return puzzleDay.String() + " (" + string(profile) + ")"
+}
+
+// checkSolution runs a part of the day on the input of the profile and reports whether it gives the accepted answer;
+// an empty accepted answer means that none is known yet
+func checkSolution(root string, puzzleDay PuzzleDay, profile Profile, puzzle int, accepted string) bool {
+	name := checkName(puzzleDay, profile)
+
+	solution, err := runSolution(root, puzzleDay, profile, puzzle)
+	switch {
+	case err != nil:
+		fmt.Printf("FAIL %s puzzle %d: %v\n", name, puzzle, err)
+		return false
+	case accepted == "":
+		fmt.Printf("?    %s puzzle %d: %s has not been accepted yet\n", name, puzzle, solution)
+	case solution != accepted:
+		fmt.Printf("FAIL %s puzzle %d: got %s, want %s\n", name, puzzle, solution, accepted)
+		return false
+	default:
+		fmt.Printf("ok   %s puzzle %d: %s\n", name, puzzle, solution)
+	}
+
+	return true
+}
+
+// runSolution runs the solution for the day on the input of the profile and returns the solution it printed
+func runSolution(root string, puzzleDay PuzzleDay, profile Profile, puzzle int) (string, error) {
+	cmd := solutionCommand(root, puzzleDay, "-puzzle", fmt.Sprintf("%d", puzzle), "-profile", string(profile))
+	cmd.Stderr = os.Stderr
+
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("error running solution: %w", err)
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		if solution, found := strings.CutPrefix(scanner.Text(), "Solution:"); found {
+			return strings.TrimSpace(solution), nil
+		}
+	}
+
+	return "", fmt.Errorf("the solution did not print a result")
+}
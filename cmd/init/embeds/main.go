@@ -1,75 +0,0 @@
-package main
-
-import (
-	_ "embed"
-	"flag"
-	"fmt"
-	"log"
-	"path/filepath"
-	"strings"
-	"time"
-
-	. "github.com/stackus/advent-of-code"
-)
-
-//go:embed input.txt
-var input string
-
-// puzzle1 solves the level 1 puzzle
-func puzzle1(input string) int {
-	parsed := parseInput(input)
-	_ = parsed
-
-	return 0
-}
-
-// puzzle2 solves the level 2 puzzle
-func puzzle2(input string) int {
-	parsed := parseInput(input)
-	_ = parsed
-
-	return 0
-}
-
-// parseInput converts the input string into whatever format is needed for the puzzle
-// update the return type as needed
-func parseInput(input string) (lines []string) {
-	for _, line := range strings.Split(input, "\n") {
-		lines = append(lines, line)
-	}
-
-	return
-}
-
-// -- leave this code alone
-func main() {
-	var puzzle int
-	flag.IntVar(&puzzle, "puzzle", 1, "puzzle number: 1 or 2")
-	flag.Parse()
-
-	// check puzzle is valid
-	if puzzle < 1 || puzzle > 2 {
-		log.Fatalf("Invalid puzzle number: %d", puzzle)
-	}
-
-	// trim input
-	input = strings.TrimRight(input, "\n")
-
-	fmt.Println("Running puzzle", puzzle)
-
-	started := time.Now()
-	solution := 0
-	if puzzle == 1 {
-		solution = puzzle1(input)
-	} else {
-		solution = puzzle2(input)
-	}
-	fmt.Println("Completed in", time.Since(started))
-
-	solutionPath := filepath.Join(GetPuzzlePath({{.Day}}, {{.Year}}), fmt.Sprintf("solution-%d.txt", puzzle))
-	err := WriteFile(solutionPath, []byte(fmt.Sprintf("%d", solution)), true)
-	if err != nil {
-		log.Fatalf("Error writing solution: %v", err)
-	}
-	fmt.Println("Solution:", solution)
-}
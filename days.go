@@ -0,0 +1,66 @@
+package advent_of_code
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+var dayDirRe = regexp.MustCompile(`^day-(\d{2})$`)
+
+// PuzzleDay is a day directory found in the repository
+type PuzzleDay struct {
+	Day  int
+	Year int
+	Path string
+}
+
+func (d PuzzleDay) String() string {
+	return fmt.Sprintf("%d/day-%02d", d.Year, d.Day)
+}
+
+// FindPuzzleDays lists every <year>/day-<day> directory under root, oldest first
+func FindPuzzleDays(root string) ([]PuzzleDay, error) {
+	yearDirs, err := os.ReadDir(root)
+	if err != nil {
+		return nil, fmt.Errorf("error reading directory: %w", err)
+	}
+
+	var days []PuzzleDay
+	for _, yearDir := range yearDirs {
+		year, err := strconv.Atoi(yearDir.Name())
+		if err != nil || !yearDir.IsDir() || year < FirstYear {
+			continue
+		}
+
+		dayDirs, err := os.ReadDir(filepath.Join(root, yearDir.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("error reading directory: %w", err)
+		}
+
+		for _, dayDir := range dayDirs {
+			matches := dayDirRe.FindStringSubmatch(dayDir.Name())
+			if matches == nil || !dayDir.IsDir() {
+				continue
+			}
+			day, _ := strconv.Atoi(matches[1])
+			days = append(days, PuzzleDay{
+				Day:  day,
+				Year: year,
+				Path: filepath.Join(root, yearDir.Name(), dayDir.Name()),
+			})
+		}
+	}
+
+	sort.Slice(days, func(i, j int) bool {
+		if days[i].Year != days[j].Year {
+			return days[i].Year < days[j].Year
+		}
+		return days[i].Day < days[j].Day
+	})
+
+	return days, nil
+}
@@ -0,0 +1,169 @@
+package advent_of_code
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+const examplesFile = "examples.json"
+
+// Examples lists the example inputs from a puzzle description and the answers the description gives for them
+type Examples struct {
+	Files []string `json:"files"`
+	// Inputs are the files that the description introduces as example inputs; the other files are diagrams
+	Inputs  []string        `json:"inputs"`
+	Answers []ExampleAnswer `json:"answers"`
+
+	inputs []string
+}
+
+// ExampleAnswer is the expected answer for a puzzle level when run against an example input
+type ExampleAnswer struct {
+	Level  int    `json:"level"`
+	Input  string `json:"input"`
+	Answer string `json:"answer"`
+}
+
+// ExampleFile returns the file name used for the nth example, counting from 1
+func ExampleFile(n int) string {
+	return fmt.Sprintf("example-%d.txt", n)
+}
+
+// phrases that introduce an example input, as opposed to a worked diagram of one
+var exampleIntroRe = regexp.MustCompile(`(?i)\b(for example|for instance|an example|another example|larger example|consider|suppose)\b`)
+
+// ExtractExamples collects the <pre><code> blocks of the puzzle description as example inputs
+//
+// The answer for each part is taken from the last <code><em> ahead of the question that ends the part, and is paired
+// with the last example input shown before it. Blocks count as inputs only when the text before them introduces an
+// example, such as "For example:"; the others are usually diagrams worked from an earlier input
+func ExtractExamples(descriptions *goquery.Selection) *Examples {
+	examples := &Examples{
+		Files:   []string{},
+		Inputs:  []string{},
+		Answers: []ExampleAnswer{},
+	}
+
+	// parts without an example of their own reuse the last one from the previous part
+	latest := ""
+
+	descriptions.Each(func(level int, article *goquery.Selection) {
+		children := article.Children()
+
+		// the question is the last paragraph asking something
+		question := -1
+		children.Each(func(i int, child *goquery.Selection) {
+			if goquery.NodeName(child) == "p" && strings.Contains(child.Text(), "?") {
+				question = i
+			}
+		})
+
+		answer, input := "", ""
+		children.Each(func(i int, child *goquery.Selection) {
+			blocks := child.Find("pre")
+			if goquery.NodeName(child) == "pre" {
+				blocks = child
+			}
+			blocks.Each(func(_ int, block *goquery.Selection) {
+				examples.inputs = append(examples.inputs, block.Text())
+				examples.Files = append(examples.Files, ExampleFile(len(examples.inputs)))
+				if introducesExample(block, child) {
+					latest = examples.Files[len(examples.Files)-1]
+					examples.Inputs = append(examples.Inputs, latest)
+				}
+			})
+
+			if i >= question {
+				return
+			}
+			// emphasis inside an example block highlights part of the example rather than giving an answer
+			found := child.Find("code > em, em > code").FilterFunction(func(_ int, s *goquery.Selection) bool {
+				return s.Closest("pre").Length() == 0
+			}).Last()
+			if found.Length() > 0 {
+				answer, input = strings.TrimSpace(found.Text()), latest
+			}
+		})
+
+		// only clear, single value answers are recorded
+		if answer == "" || input == "" || strings.ContainsAny(answer, " \n") {
+			return
+		}
+
+		examples.Answers = append(examples.Answers, ExampleAnswer{
+			Level:  level + 1,
+			Input:  input,
+			Answer: answer,
+		})
+	})
+
+	return examples
+}
+
+// introducesExample reports whether the text just before the block, which is inside child, announces an example input
+func introducesExample(block, child *goquery.Selection) bool {
+	intro := block.Prev()
+	if intro.Length() == 0 {
+		intro = child.Prev()
+	}
+	text := strings.TrimSpace(intro.Text())
+
+	return strings.HasSuffix(text, ":") && exampleIntroRe.MatchString(text)
+}
+
+// Write saves the example inputs and the examples file into the puzzle directory
+func (e *Examples) Write(puzzlePath string) error {
+	for i, input := range e.inputs {
+		err := WriteFile(filepath.Join(puzzlePath, e.Files[i]), []byte(input), true)
+		if err != nil {
+			return fmt.Errorf("error writing example: %w", err)
+		}
+	}
+
+	contents, err := json.MarshalIndent(e, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding examples: %w", err)
+	}
+
+	return WriteFile(filepath.Join(puzzlePath, examplesFile), contents, true)
+}
+
+// LoadExamples reads the examples file from the puzzle directory
+func LoadExamples(puzzlePath string) (*Examples, error) {
+	contents, err := os.ReadFile(filepath.Join(puzzlePath, examplesFile))
+	if err != nil {
+		return nil, err
+	}
+
+	examples := &Examples{}
+	if err = json.Unmarshal(contents, examples); err != nil {
+		return nil, fmt.Errorf("error reading examples: %w", err)
+	}
+
+	return examples, nil
+}
+
+// Answer returns the expected example answer for the level
+func (e *Examples) Answer(level int) (ExampleAnswer, bool) {
+	for _, answer := range e.Answers {
+		if answer.Level == level {
+			return answer, true
+		}
+	}
+
+	return ExampleAnswer{}, false
+}
+
+// IsInput reports whether the file was introduced as an example input by the description
+//
+// Examples files written before inputs were told apart from diagrams list no inputs at all
+func (e *Examples) IsInput(file string) bool {
+	return slices.Contains(e.Inputs, file)
+}
@@ -0,0 +1,147 @@
+package advent_of_code
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// descriptions parses the articles of a puzzle page
+func descriptions(t *testing.T, articles ...string) *goquery.Selection {
+	t.Helper()
+
+	page := "<html><body><main>"
+	for _, article := range articles {
+		page += `<article class="day-desc">` + article + "</article>"
+	}
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(page + "</main></body></html>"))
+	if err != nil {
+		t.Fatalf("NewDocumentFromReader() error = %v", err)
+	}
+
+	return doc.Find(".day-desc")
+}
+
+func TestExtractExamples(t *testing.T) {
+	tests := map[string]struct {
+		articles []string
+		files    []string
+		inputs   []string
+		answers  []ExampleAnswer
+	}{
+		"one example for both parts": {
+			articles: []string{
+				`<h2>--- Day 1 ---</h2><p>For example:</p><pre><code>1abc2
+pqr3stu8vwx
+</code></pre><p>Adding these together produces <code><em>142</em></code>.</p><p>What is the sum?</p>`,
+				`<h2>--- Part Two ---</h2><p>The sum is now <code><em>77</em></code>.</p><p>What is the new sum?</p>`,
+			},
+			files:  []string{"example-1.txt"},
+			inputs: []string{"example-1.txt"},
+			answers: []ExampleAnswer{
+				{Level: 1, Input: "example-1.txt", Answer: "142"},
+				{Level: 2, Input: "example-1.txt", Answer: "77"},
+			},
+		},
+		"a new example for part two": {
+			articles: []string{
+				`<p>For example:</p><pre><code>a
+</code></pre><p>The answer is <code><em>1</em></code>.</p><p>What is it?</p>`,
+				`<p>Here is another example:</p><pre><code>b
+</code></pre><p>The answer is <code><em>2</em></code>.</p><p>What is it now?</p>`,
+			},
+			files:  []string{"example-1.txt", "example-2.txt"},
+			inputs: []string{"example-1.txt", "example-2.txt"},
+			answers: []ExampleAnswer{
+				{Level: 1, Input: "example-1.txt", Answer: "1"},
+				{Level: 2, Input: "example-2.txt", Answer: "2"},
+			},
+		},
+		"diagram in part two": {
+			// modelled on 2023 day 9, where part two works the earlier example backwards
+			articles: []string{
+				`<p>For example:</p><pre><code>0 3 6 9 12 15
+10 13 16 21 30 45
+</code></pre><p>Visually, these sequences can be arranged like this:</p><pre><code>0   3   6   9  12  15   <em>18</em>
+  3   3   3   3   3   <em>3</em>
+</code></pre><p>The sum of these extrapolated values is <code><em>114</em></code>.</p><p>What is the sum?</p>`,
+				`<p>Here is what the third example history looks like when extrapolating back in time:</p><pre><code><em>5</em>  10  13  16  21  30  45
+  <em>5</em>   3   3   5   9  15
+</code></pre><p>Adding the new values together gives <code><em>2</em></code>.</p><p>What is the sum now?</p>`,
+			},
+			files:  []string{"example-1.txt", "example-2.txt", "example-3.txt"},
+			inputs: []string{"example-1.txt"},
+			answers: []ExampleAnswer{
+				{Level: 1, Input: "example-1.txt", Answer: "114"},
+				{Level: 2, Input: "example-1.txt", Answer: "2"},
+			},
+		},
+		"diagram before any example": {
+			articles: []string{
+				`<p>The map looks like this:</p><pre><code>#.#
+</code></pre><p>There are <code><em>2</em></code> walls.</p><p>How many walls are there?</p>`,
+			},
+			files:   []string{"example-1.txt"},
+			inputs:  []string{},
+			answers: []ExampleAnswer{},
+		},
+		"emphasis after the question": {
+			articles: []string{
+				`<p>For example:</p><pre><code>a
+</code></pre><p>The answer is <code><em>1</em></code>.</p><p>What is it?</p><p>Not <code><em>3</em></code>.</p>`,
+			},
+			files:   []string{"example-1.txt"},
+			inputs:  []string{"example-1.txt"},
+			answers: []ExampleAnswer{{Level: 1, Input: "example-1.txt", Answer: "1"}},
+		},
+		"answer with several values": {
+			articles: []string{
+				`<p>For example:</p><pre><code>a
+</code></pre><p>The order is <code><em>4 5 6</em></code>.</p><p>What is the order?</p>`,
+			},
+			files:   []string{"example-1.txt"},
+			inputs:  []string{"example-1.txt"},
+			answers: []ExampleAnswer{},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			examples := ExtractExamples(descriptions(t, tc.articles...))
+			if !slices.Equal(examples.Files, tc.files) {
+				t.Errorf("Files = %v, want %v", examples.Files, tc.files)
+			}
+			if !slices.Equal(examples.Inputs, tc.inputs) {
+				t.Errorf("Inputs = %v, want %v", examples.Inputs, tc.inputs)
+			}
+			if !slices.Equal(examples.Answers, tc.answers) {
+				t.Errorf("Answers = %+v, want %+v", examples.Answers, tc.answers)
+			}
+		})
+	}
+}
+
+func TestExamplesWriteAndLoad(t *testing.T) {
+	examples := ExtractExamples(descriptions(t, `<p>For example:</p><pre><code>1abc2
+</code></pre><p>The sum is <code><em>12</em></code>.</p><p>What is the sum?</p>`))
+
+	dir := t.TempDir()
+	if err := examples.Write(dir); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	loaded, err := LoadExamples(dir)
+	if err != nil {
+		t.Fatalf("LoadExamples() error = %v", err)
+	}
+	if answer, ok := loaded.Answer(1); !ok || answer.Input != "example-1.txt" || answer.Answer != "12" {
+		t.Errorf("Answer(1) = %+v, %t; want 12 for example-1.txt", answer, ok)
+	}
+	if !loaded.IsInput("example-1.txt") {
+		t.Error("IsInput(example-1.txt) = false, want true")
+	}
+	if _, ok := loaded.Answer(2); ok {
+		t.Error("Answer(2) found an answer that the description does not give")
+	}
+}
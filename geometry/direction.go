@@ -0,0 +1,90 @@
+package geometry
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Direction is one of the four orthogonal directions on the 2D plane
+type Direction int
+
+const (
+	Up Direction = iota
+	Right
+	Down
+	Left
+)
+
+// compass names for the directions
+const (
+	North = Up
+	East  = Right
+	South = Down
+	West  = Left
+)
+
+// Directions lists the directions clockwise from up
+var Directions = [4]Direction{Up, Right, Down, Left}
+
+var directionNames = [4]string{"up", "right", "down", "left"}
+
+// ParseDirection reads a direction from a letter or arrow: U R D L, N E S W (in either case) or ^ > v <
+func ParseDirection(r rune) (Direction, error) {
+	switch r {
+	case 'U', 'u', 'N', 'n', '^':
+		return Up, nil
+	case 'R', 'r', 'E', 'e', '>':
+		return Right, nil
+	case 'D', 'd', 'S', 's', 'v':
+		return Down, nil
+	case 'L', 'l', 'W', 'w', '<':
+		return Left, nil
+	}
+
+	return 0, fmt.Errorf("invalid direction: %q", r)
+}
+
+// TurnRight returns the direction a quarter turn clockwise
+func (d Direction) TurnRight() Direction {
+	return (d + 1) % 4
+}
+
+// TurnLeft returns the direction a quarter turn counterclockwise
+func (d Direction) TurnLeft() Direction {
+	return (d + 3) % 4
+}
+
+// Reverse returns the opposite direction
+func (d Direction) Reverse() Direction {
+	return (d + 2) % 4
+}
+
+func (d Direction) String() string {
+	if !d.Valid() {
+		return fmt.Sprintf("Direction(%d)", int(d))
+	}
+
+	return directionNames[d]
+}
+
+// Valid reports whether d is one of the four directions
+func (d Direction) Valid() bool {
+	return d >= Up && d <= Left
+}
+
+// Unit returns the offset of a single step in the direction; it panics when the direction is not valid
+func Unit[T constraints.Signed](d Direction) Point[T] {
+	switch d {
+	case Up:
+		return Point[T]{Y: -1}
+	case Right:
+		return Point[T]{X: 1}
+	case Down:
+		return Point[T]{Y: 1}
+	case Left:
+		return Point[T]{X: -1}
+	}
+
+	panic(fmt.Sprintf("geometry: invalid direction %d", int(d)))
+}
@@ -0,0 +1,51 @@
+package geometry
+
+import (
+	"testing"
+)
+
+func TestDirectionString(t *testing.T) {
+	tests := map[Direction]string{
+		Up:            "up",
+		Right:         "right",
+		Down:          "down",
+		Left:          "left",
+		Direction(4):  "Direction(4)",
+		Direction(-1): "Direction(-1)",
+	}
+	for d, want := range tests {
+		if got := d.String(); got != want {
+			t.Errorf("Direction(%d).String() = %q, want %q", int(d), got, want)
+		}
+	}
+}
+
+func TestUnit(t *testing.T) {
+	for _, d := range Directions {
+		// each unit step is undone by a step in the reverse direction
+		if got := Unit[int](d).Add(Unit[int](d.Reverse())); got != Pt(0, 0) {
+			t.Errorf("Unit(%s) + Unit(%s) = %s, want 0,0", d, d.Reverse(), got)
+		}
+	}
+	if got := Unit[int](Left); got != Pt(-1, 0) {
+		t.Errorf("Unit(left) = %s, want -1,0", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Unit(Direction(4)) did not panic")
+		}
+	}()
+	Unit[int](Direction(4))
+}
+
+func TestTurns(t *testing.T) {
+	for _, d := range Directions {
+		if got := d.TurnRight().TurnLeft(); got != d {
+			t.Errorf("%s turned right then left = %s", d, got)
+		}
+		if got := d.TurnRight().TurnRight(); got != d.Reverse() {
+			t.Errorf("%s turned right twice = %s, want %s", d, got, d.Reverse())
+		}
+	}
+}
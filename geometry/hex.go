@@ -0,0 +1,115 @@
+package geometry
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Hex is a position, or an offset, on a hexagonal grid in axial coordinates
+//
+// The same coordinates work for grids with pointy tops, where rows run east to west, and for grids with flat tops,
+// where columns run north to south; only the names of the directions differ
+type Hex[T constraints.Signed] struct {
+	Q T
+	R T
+}
+
+// HexDirection is one of the six directions on a hexagonal grid, numbered clockwise
+type HexDirection int
+
+// HexDirections lists the directions clockwise
+var HexDirections = [6]HexDirection{0, 1, 2, 3, 4, 5}
+
+// axial offsets of the directions, clockwise
+var hexOffsets = [6][2]int{{1, 0}, {0, 1}, {-1, 1}, {-1, 0}, {0, -1}, {1, -1}}
+
+// direction names for each layout, in the same order as hexOffsets
+var (
+	pointyHexNames = [6]string{"e", "se", "sw", "w", "nw", "ne"}
+	flatHexNames   = [6]string{"se", "s", "sw", "nw", "n", "ne"}
+)
+
+// ParsePointyHexDirection reads a direction on a pointy topped grid: e, se, sw, w, nw or ne
+func ParsePointyHexDirection(name string) (HexDirection, error) {
+	return parseHexDirection(name, pointyHexNames)
+}
+
+// ParseFlatHexDirection reads a direction on a flat topped grid: n, ne, se, s, sw or nw
+func ParseFlatHexDirection(name string) (HexDirection, error) {
+	return parseHexDirection(name, flatHexNames)
+}
+
+func parseHexDirection(name string, names [6]string) (HexDirection, error) {
+	for i, n := range names {
+		if strings.EqualFold(name, n) {
+			return HexDirection(i), nil
+		}
+	}
+
+	return 0, fmt.Errorf("invalid hex direction: %q", name)
+}
+
+// TurnRight returns the next direction clockwise
+func (d HexDirection) TurnRight() HexDirection {
+	return (d + 1) % 6
+}
+
+// TurnLeft returns the next direction counterclockwise
+func (d HexDirection) TurnLeft() HexDirection {
+	return (d + 5) % 6
+}
+
+// Reverse returns the opposite direction
+func (d HexDirection) Reverse() HexDirection {
+	return (d + 3) % 6
+}
+
+// HexUnit returns the offset of a single step in the direction
+func HexUnit[T constraints.Signed](d HexDirection) Hex[T] {
+	offset := hexOffsets[d]
+
+	return Hex[T]{Q: T(offset[0]), R: T(offset[1])}
+}
+
+// Add returns h moved by o
+func (h Hex[T]) Add(o Hex[T]) Hex[T] {
+	return Hex[T]{Q: h.Q + o.Q, R: h.R + o.R}
+}
+
+// Sub returns the offset from o to h
+func (h Hex[T]) Sub(o Hex[T]) Hex[T] {
+	return Hex[T]{Q: h.Q - o.Q, R: h.R - o.R}
+}
+
+// Scale returns h multiplied by k
+func (h Hex[T]) Scale(k T) Hex[T] {
+	return Hex[T]{Q: h.Q * k, R: h.R * k}
+}
+
+// Step returns the neighbor of h in the direction
+func (h Hex[T]) Step(d HexDirection) Hex[T] {
+	return h.Add(HexUnit[T](d))
+}
+
+// Neighbors returns the six neighbors of h, clockwise
+func (h Hex[T]) Neighbors() [6]Hex[T] {
+	var neighbors [6]Hex[T]
+	for i, d := range HexDirections {
+		neighbors[i] = h.Step(d)
+	}
+
+	return neighbors
+}
+
+// Distance returns the number of steps between h and o
+func (h Hex[T]) Distance(o Hex[T]) T {
+	d := h.Sub(o)
+
+	return (Abs(d.Q) + Abs(d.R) + Abs(d.Q+d.R)) / 2
+}
+
+func (h Hex[T]) String() string {
+	return fmt.Sprintf("%d,%d", h.Q, h.R)
+}
@@ -0,0 +1,83 @@
+package geometry
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Point is a position, or an offset between positions, on a 2D plane where y grows downwards like the rows of the
+// input
+type Point[T constraints.Signed] struct {
+	X T
+	Y T
+}
+
+// Pt returns the point x,y
+func Pt[T constraints.Signed](x, y T) Point[T] {
+	return Point[T]{X: x, Y: y}
+}
+
+// Add returns p moved by q
+func (p Point[T]) Add(q Point[T]) Point[T] {
+	return Point[T]{X: p.X + q.X, Y: p.Y + q.Y}
+}
+
+// Sub returns the offset from q to p
+func (p Point[T]) Sub(q Point[T]) Point[T] {
+	return Point[T]{X: p.X - q.X, Y: p.Y - q.Y}
+}
+
+// Scale returns p multiplied by k
+func (p Point[T]) Scale(k T) Point[T] {
+	return Point[T]{X: p.X * k, Y: p.Y * k}
+}
+
+// Manhattan returns the taxicab distance between p and q
+func (p Point[T]) Manhattan(q Point[T]) T {
+	return Abs(p.X-q.X) + Abs(p.Y-q.Y)
+}
+
+// Move returns p moved n steps in the direction
+func (p Point[T]) Move(d Direction, n T) Point[T] {
+	return p.Add(Unit[T](d).Scale(n))
+}
+
+// Step returns the neighbor of p in the direction
+func (p Point[T]) Step(d Direction) Point[T] {
+	return p.Add(Unit[T](d))
+}
+
+// Neighbors4 returns the orthogonal neighbors of p: up, right, down then left
+func (p Point[T]) Neighbors4() [4]Point[T] {
+	var neighbors [4]Point[T]
+	for i, d := range Directions {
+		neighbors[i] = p.Step(d)
+	}
+
+	return neighbors
+}
+
+// Neighbors8 returns the orthogonal and diagonal neighbors of p, clockwise from up
+func (p Point[T]) Neighbors8() [8]Point[T] {
+	var neighbors [8]Point[T]
+	for i, d := range Directions {
+		neighbors[i*2] = p.Step(d)
+		neighbors[i*2+1] = p.Step(d).Step(d.TurnRight())
+	}
+
+	return neighbors
+}
+
+func (p Point[T]) String() string {
+	return fmt.Sprintf("%d,%d", p.X, p.Y)
+}
+
+// Abs returns the absolute value of n
+func Abs[T constraints.Signed](n T) T {
+	if n < 0 {
+		return -n
+	}
+
+	return n
+}
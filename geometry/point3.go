@@ -0,0 +1,55 @@
+package geometry
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Point3 is a position, or an offset between positions, in 3D space
+type Point3[T constraints.Signed] struct {
+	X T
+	Y T
+	Z T
+}
+
+// Pt3 returns the point x,y,z
+func Pt3[T constraints.Signed](x, y, z T) Point3[T] {
+	return Point3[T]{X: x, Y: y, Z: z}
+}
+
+// Add returns p moved by q
+func (p Point3[T]) Add(q Point3[T]) Point3[T] {
+	return Point3[T]{X: p.X + q.X, Y: p.Y + q.Y, Z: p.Z + q.Z}
+}
+
+// Sub returns the offset from q to p
+func (p Point3[T]) Sub(q Point3[T]) Point3[T] {
+	return Point3[T]{X: p.X - q.X, Y: p.Y - q.Y, Z: p.Z - q.Z}
+}
+
+// Scale returns p multiplied by k
+func (p Point3[T]) Scale(k T) Point3[T] {
+	return Point3[T]{X: p.X * k, Y: p.Y * k, Z: p.Z * k}
+}
+
+// Manhattan returns the taxicab distance between p and q
+func (p Point3[T]) Manhattan(q Point3[T]) T {
+	return Abs(p.X-q.X) + Abs(p.Y-q.Y) + Abs(p.Z-q.Z)
+}
+
+// Neighbors6 returns the points sharing a face with p
+func (p Point3[T]) Neighbors6() [6]Point3[T] {
+	return [6]Point3[T]{
+		{X: p.X + 1, Y: p.Y, Z: p.Z},
+		{X: p.X - 1, Y: p.Y, Z: p.Z},
+		{X: p.X, Y: p.Y + 1, Z: p.Z},
+		{X: p.X, Y: p.Y - 1, Z: p.Z},
+		{X: p.X, Y: p.Y, Z: p.Z + 1},
+		{X: p.X, Y: p.Y, Z: p.Z - 1},
+	}
+}
+
+func (p Point3[T]) String() string {
+	return fmt.Sprintf("%d,%d,%d", p.X, p.Y, p.Z)
+}
@@ -0,0 +1,115 @@
+package geometry
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// Polygon is a closed shape given by its vertices in order around it; the last vertex joins back to the first
+//
+// The lattice point counts treat the vertices as grid positions, so they suit loops traced through the input
+type Polygon[T constraints.Signed] []Point[T]
+
+// Move is a run of steps in a straight line
+type Move[T constraints.Signed] struct {
+	Direction Direction
+	Distance  T
+}
+
+// PolygonFromMoves returns the polygon traced by following the moves from start, with a vertex at the end of each
+// move; the moves should end back at start
+func PolygonFromMoves[T constraints.Signed](start Point[T], moves []Move[T]) Polygon[T] {
+	polygon := make(Polygon[T], 0, len(moves))
+	at := start
+	for _, move := range moves {
+		at = at.Move(move.Direction, move.Distance)
+		polygon = append(polygon, at)
+	}
+
+	return polygon
+}
+
+// DoubleArea returns twice the area of the polygon using the shoelace formula; the result is positive when the
+// vertices run clockwise, and always a whole number for lattice polygons
+func (p Polygon[T]) DoubleArea() T {
+	var sum T
+	for i, a := range p {
+		b := p[(i+1)%len(p)]
+		sum += a.X*b.Y - b.X*a.Y
+	}
+
+	return sum
+}
+
+// Area returns the area of the polygon, rounded down to a whole number
+func (p Polygon[T]) Area() T {
+	return Abs(p.DoubleArea()) / 2
+}
+
+// Boundary returns the number of lattice points on the edges of the polygon
+func (p Polygon[T]) Boundary() T {
+	var count T
+	for i, a := range p {
+		d := p[(i+1)%len(p)].Sub(a)
+		count += gcd(Abs(d.X), Abs(d.Y))
+	}
+
+	return count
+}
+
+// Interior returns the number of lattice points strictly inside the polygon using Pick's theorem
+func (p Polygon[T]) Interior() T {
+	return (Abs(p.DoubleArea()) - p.Boundary() + 2) / 2
+}
+
+// LatticePoints returns the number of lattice points inside or on the edges of the polygon
+func (p Polygon[T]) LatticePoints() T {
+	return p.Interior() + p.Boundary()
+}
+
+// OnBoundary reports whether q lies on an edge of the polygon
+func (p Polygon[T]) OnBoundary(q Point[T]) bool {
+	for i, a := range p {
+		b := p[(i+1)%len(p)]
+		if cross(a, b, q) == 0 &&
+			q.X >= min(a.X, b.X) && q.X <= max(a.X, b.X) && q.Y >= min(a.Y, b.Y) && q.Y <= max(a.Y, b.Y) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Contains reports whether q lies strictly inside the polygon; points on the edges are not contained
+func (p Polygon[T]) Contains(q Point[T]) bool {
+	if p.OnBoundary(q) {
+		return false
+	}
+
+	// cast a ray to the right of q and count the edges it crosses
+	inside := false
+	for i, a := range p {
+		b := p[(i+1)%len(p)]
+		if (a.Y > q.Y) == (b.Y > q.Y) {
+			continue
+		}
+		// the edge crosses the ray when it passes the height of q somewhere to the right of q
+		if (cross(a, b, q) > 0) == (b.Y > a.Y) {
+			inside = !inside
+		}
+	}
+
+	return inside
+}
+
+// cross returns the cross product of a->b and a->q, which is zero when q is on the line through a and b
+func cross[T constraints.Signed](a, b, q Point[T]) T {
+	return (b.X-a.X)*(q.Y-a.Y) - (q.X-a.X)*(b.Y-a.Y)
+}
+
+func gcd[T constraints.Signed](a, b T) T {
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	return a
+}
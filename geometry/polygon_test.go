@@ -0,0 +1,119 @@
+package geometry
+
+import (
+	"testing"
+)
+
+// lagoon is the dig plan of the example of 2023 day 18
+func lagoon() Polygon[int] {
+	return PolygonFromMoves(Pt(0, 0), []Move[int]{
+		{Right, 6}, {Down, 5}, {Left, 2}, {Down, 2}, {Right, 2}, {Down, 2}, {Left, 5},
+		{Up, 2}, {Left, 1}, {Up, 2}, {Right, 2}, {Up, 3}, {Left, 2}, {Up, 2},
+	})
+}
+
+func TestPolygonLatticePoints(t *testing.T) {
+	tests := map[string]struct {
+		polygon  Polygon[int]
+		area     int
+		boundary int
+		interior int
+	}{
+		"square": {
+			polygon:  Polygon[int]{Pt(0, 0), Pt(2, 0), Pt(2, 2), Pt(0, 2)},
+			area:     4,
+			boundary: 8,
+			interior: 1,
+		},
+		"counterclockwise square": {
+			polygon:  Polygon[int]{Pt(0, 0), Pt(0, 2), Pt(2, 2), Pt(2, 0)},
+			area:     4,
+			boundary: 8,
+			interior: 1,
+		},
+		"diagonal triangle": {
+			polygon:  Polygon[int]{Pt(0, 0), Pt(4, 0), Pt(0, 4)},
+			area:     8,
+			boundary: 12,
+			interior: 3,
+		},
+		"2023 day 18 example": {
+			polygon:  lagoon(),
+			area:     42,
+			boundary: 38,
+			interior: 24,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.polygon.Area(); got != tc.area {
+				t.Errorf("Area() = %d, want %d", got, tc.area)
+			}
+			if got := tc.polygon.Boundary(); got != tc.boundary {
+				t.Errorf("Boundary() = %d, want %d", got, tc.boundary)
+			}
+			if got := tc.polygon.Interior(); got != tc.interior {
+				t.Errorf("Interior() = %d, want %d", got, tc.interior)
+			}
+			if got := tc.polygon.LatticePoints(); got != tc.interior+tc.boundary {
+				t.Errorf("LatticePoints() = %d, want %d", got, tc.interior+tc.boundary)
+			}
+		})
+	}
+}
+
+func TestPolygonContains(t *testing.T) {
+	polygon := lagoon()
+
+	tests := map[string]struct {
+		point      Point[int]
+		contains   bool
+		onBoundary bool
+	}{
+		"inside":                      {point: Pt(1, 1), contains: true},
+		"vertex":                      {point: Pt(0, 0), onBoundary: true},
+		"edge":                        {point: Pt(3, 0), onBoundary: true},
+		"outside":                     {point: Pt(7, 3)},
+		"in the left notch":           {point: Pt(1, 3)},
+		"in the right notch":          {point: Pt(5, 6)},
+		"between the notches":         {point: Pt(3, 6), contains: true},
+		"inside level with vertices":  {point: Pt(3, 5), contains: true},
+		"outside level with vertices": {point: Pt(-1, 5)},
+		"right of the lagoon level with vertices": {point: Pt(7, 7)},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := polygon.Contains(tc.point); got != tc.contains {
+				t.Errorf("Contains(%s) = %t, want %t", tc.point, got, tc.contains)
+			}
+			if got := polygon.OnBoundary(tc.point); got != tc.onBoundary {
+				t.Errorf("OnBoundary(%s) = %t, want %t", tc.point, got, tc.onBoundary)
+			}
+		})
+	}
+}
+
+func TestPolygonCountsMatchContains(t *testing.T) {
+	polygon := lagoon()
+
+	interior, boundary := 0, 0
+	for y := -1; y <= 10; y++ {
+		for x := -1; x <= 7; x++ {
+			switch {
+			case polygon.Contains(Pt(x, y)):
+				interior++
+			case polygon.OnBoundary(Pt(x, y)):
+				boundary++
+			}
+		}
+	}
+
+	if interior != polygon.Interior() || boundary != polygon.Boundary() {
+		t.Errorf("counted %d inside and %d on the boundary, want %d and %d", interior, boundary,
+			polygon.Interior(), polygon.Boundary())
+	}
+	// the answer to part one of the example
+	if got := polygon.LatticePoints(); got != 62 {
+		t.Errorf("LatticePoints() = %d, want 62", got)
+	}
+}
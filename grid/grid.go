@@ -0,0 +1,267 @@
+package grid
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stackus/advent-of-code/geometry"
+)
+
+// Grid is a rectangular grid of cells addressed by column x and row y, with 0,0 in the top left corner
+type Grid[T any] struct {
+	Width  int
+	Height int
+
+	// cells are stored row by row
+	cells []T
+}
+
+// Cell is the position and value of a single cell
+type Cell[T any] struct {
+	X     int
+	Y     int
+	Value T
+}
+
+// offsets of the neighbors of a cell; the first four are the orthogonal ones
+var neighborOffsets = [8][2]int{
+	{0, -1}, {1, 0}, {0, 1}, {-1, 0},
+	{1, -1}, {1, 1}, {-1, 1}, {-1, -1},
+}
+
+// New returns a grid of the given size with every cell set to the zero value
+func New[T any](width, height int) *Grid[T] {
+	return &Grid[T]{
+		Width:  width,
+		Height: height,
+		cells:  make([]T, width*height),
+	}
+}
+
+// Parse reads a grid of runes with one row per line of the input
+func Parse(input string) *Grid[rune] {
+	return ParseFunc(input, func(r rune) rune { return r })
+}
+
+// ParseFunc reads a grid with one row per line of the input, converting each rune into a cell value
+//
+// Lines shorter than the longest one are padded with the zero value, and an empty input gives an empty grid
+func ParseFunc[T any](input string, convert func(r rune) T) *Grid[T] {
+	input = strings.TrimRight(input, "\n")
+	if input == "" {
+		return New[T](0, 0)
+	}
+
+	lines := strings.Split(input, "\n")
+
+	width := 0
+	for _, line := range lines {
+		width = max(width, len([]rune(line)))
+	}
+
+	g := New[T](width, len(lines))
+	for y, line := range lines {
+		for x, r := range []rune(line) {
+			g.Set(x, y, convert(r))
+		}
+	}
+
+	return g
+}
+
+// In reports whether x,y is inside the grid
+func (g *Grid[T]) In(x, y int) bool {
+	return x >= 0 && x < g.Width && y >= 0 && y < g.Height
+}
+
+// Get returns the value at x,y; it panics when x,y is outside the grid
+func (g *Grid[T]) Get(x, y int) T {
+	g.check(x, y)
+
+	return g.cells[y*g.Width+x]
+}
+
+// Lookup returns the value at x,y, or false when x,y is outside the grid
+func (g *Grid[T]) Lookup(x, y int) (T, bool) {
+	if !g.In(x, y) {
+		var zero T
+		return zero, false
+	}
+
+	return g.cells[y*g.Width+x], true
+}
+
+// Set changes the value at x,y; it panics when x,y is outside the grid
+func (g *Grid[T]) Set(x, y int, value T) {
+	g.check(x, y)
+
+	g.cells[y*g.Width+x] = value
+}
+
+func (g *Grid[T]) check(x, y int) {
+	if !g.In(x, y) {
+		panic(fmt.Sprintf("grid: %d,%d is outside the %dx%d grid", x, y, g.Width, g.Height))
+	}
+}
+
+// Each calls fn for every cell, row by row
+func (g *Grid[T]) Each(fn func(x, y int, value T)) {
+	for y := 0; y < g.Height; y++ {
+		for x := 0; x < g.Width; x++ {
+			fn(x, y, g.cells[y*g.Width+x])
+		}
+	}
+}
+
+// Neighbors4 calls fn for each orthogonal neighbor of x,y inside the grid: up, right, down then left
+func (g *Grid[T]) Neighbors4(x, y int, fn func(nx, ny int, value T)) {
+	g.neighbors(x, y, neighborOffsets[:4], fn)
+}
+
+// Neighbors8 calls fn for each orthogonal and diagonal neighbor of x,y inside the grid
+func (g *Grid[T]) Neighbors8(x, y int, fn func(nx, ny int, value T)) {
+	g.neighbors(x, y, neighborOffsets[:], fn)
+}
+
+func (g *Grid[T]) neighbors(x, y int, offsets [][2]int, fn func(nx, ny int, value T)) {
+	for _, offset := range offsets {
+		nx, ny := x+offset[0], y+offset[1]
+		if g.In(nx, ny) {
+			fn(nx, ny, g.cells[ny*g.Width+nx])
+		}
+	}
+}
+
+// Row returns row y; the slice shares the cells of the grid, so changes to it change the grid
+func (g *Grid[T]) Row(y int) []T {
+	// rows are checked on their own so that the rows of a grid without columns can still be read
+	if y < 0 || y >= g.Height {
+		panic(fmt.Sprintf("grid: row %d is outside the %dx%d grid", y, g.Width, g.Height))
+	}
+
+	return g.cells[y*g.Width : (y+1)*g.Width : (y+1)*g.Width]
+}
+
+// Column returns a copy of column x
+func (g *Grid[T]) Column(x int) []T {
+	if x < 0 || x >= g.Width {
+		panic(fmt.Sprintf("grid: column %d is outside the %dx%d grid", x, g.Width, g.Height))
+	}
+
+	column := make([]T, g.Height)
+	for y := range column {
+		column[y] = g.cells[y*g.Width+x]
+	}
+
+	return column
+}
+
+// Find returns the first cell, row by row, whose value matches
+func (g *Grid[T]) Find(match func(value T) bool) (Cell[T], bool) {
+	for i, value := range g.cells {
+		if match(value) {
+			return Cell[T]{X: i % g.Width, Y: i / g.Width, Value: value}, true
+		}
+	}
+
+	return Cell[T]{}, false
+}
+
+// FindAll returns every cell, row by row, whose value matches
+func (g *Grid[T]) FindAll(match func(value T) bool) []Cell[T] {
+	var cells []Cell[T]
+	for i, value := range g.cells {
+		if match(value) {
+			cells = append(cells, Cell[T]{X: i % g.Width, Y: i / g.Width, Value: value})
+		}
+	}
+
+	return cells
+}
+
+// Clone returns a copy of the grid
+func (g *Grid[T]) Clone() *Grid[T] {
+	return &Grid[T]{
+		Width:  g.Width,
+		Height: g.Height,
+		cells:  append([]T(nil), g.cells...),
+	}
+}
+
+// Transpose returns a new grid with the rows and columns swapped
+func (g *Grid[T]) Transpose() *Grid[T] {
+	return g.remap(g.Height, g.Width, func(x, y int) (int, int) { return y, x })
+}
+
+// RotateClockwise returns a new grid turned a quarter turn clockwise
+func (g *Grid[T]) RotateClockwise() *Grid[T] {
+	return g.remap(g.Height, g.Width, func(x, y int) (int, int) { return g.Height - 1 - y, x })
+}
+
+// RotateCounterClockwise returns a new grid turned a quarter turn counterclockwise
+func (g *Grid[T]) RotateCounterClockwise() *Grid[T] {
+	return g.remap(g.Height, g.Width, func(x, y int) (int, int) { return y, g.Width - 1 - x })
+}
+
+// FlipHorizontal returns a new grid mirrored left to right
+func (g *Grid[T]) FlipHorizontal() *Grid[T] {
+	return g.remap(g.Width, g.Height, func(x, y int) (int, int) { return g.Width - 1 - x, y })
+}
+
+// FlipVertical returns a new grid mirrored top to bottom
+func (g *Grid[T]) FlipVertical() *Grid[T] {
+	return g.remap(g.Width, g.Height, func(x, y int) (int, int) { return x, g.Height - 1 - y })
+}
+
+// remap builds a grid of the given size, moving each cell at x,y to the position returned by to
+func (g *Grid[T]) remap(width, height int, to func(x, y int) (int, int)) *Grid[T] {
+	remapped := New[T](width, height)
+	g.Each(func(x, y int, value T) {
+		nx, ny := to(x, y)
+		remapped.Set(nx, ny, value)
+	})
+
+	return remapped
+}
+
+// String renders the grid one row per line; runes and bytes are written as characters and other values with fmt
+func (g *Grid[T]) String() string {
+	buf := strings.Builder{}
+	for y := 0; y < g.Height; y++ {
+		for _, value := range g.Row(y) {
+			switch v := any(value).(type) {
+			case rune:
+				buf.WriteRune(v)
+			case byte:
+				buf.WriteByte(v)
+			default:
+				fmt.Fprint(&buf, v)
+			}
+		}
+		buf.WriteString("\n")
+	}
+
+	return buf.String()
+}
+
+// Adjacent4 returns the moves between orthogonal neighbors as a function for the traversals of the search package;
+// canMove decides which moves are allowed, and a nil canMove allows them all
+func (g *Grid[T]) Adjacent4(canMove func(from, to Cell[T]) bool) func(p geometry.Point[int], visit func(next geometry.Point[int])) {
+	return g.adjacent(neighborOffsets[:4], canMove)
+}
+
+// Adjacent8 is Adjacent4 with diagonal moves as well
+func (g *Grid[T]) Adjacent8(canMove func(from, to Cell[T]) bool) func(p geometry.Point[int], visit func(next geometry.Point[int])) {
+	return g.adjacent(neighborOffsets[:], canMove)
+}
+
+func (g *Grid[T]) adjacent(offsets [][2]int, canMove func(from, to Cell[T]) bool) func(p geometry.Point[int], visit func(next geometry.Point[int])) {
+	return func(p geometry.Point[int], visit func(next geometry.Point[int])) {
+		from := Cell[T]{X: p.X, Y: p.Y, Value: g.Get(p.X, p.Y)}
+		g.neighbors(p.X, p.Y, offsets, func(nx, ny int, value T) {
+			if canMove == nil || canMove(from, Cell[T]{X: nx, Y: ny, Value: value}) {
+				visit(geometry.Pt(nx, ny))
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package grid
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	g := Parse("ab\nc\n")
+	if g.Width != 2 || g.Height != 2 {
+		t.Fatalf("size = %dx%d, want 2x2", g.Width, g.Height)
+	}
+	// the short line is padded with the zero value
+	if got := g.Get(1, 1); got != 0 {
+		t.Errorf("Get(1, 1) = %q, want the zero value", got)
+	}
+	if got := g.String(); got != "ab\nc\x00\n" {
+		t.Errorf("String() = %q", got)
+	}
+}
+
+func TestParseEmpty(t *testing.T) {
+	for _, input := range []string{"", "\n", "\n\n"} {
+		g := Parse(input)
+		if g.Width != 0 || g.Height != 0 {
+			t.Errorf("Parse(%q) size = %dx%d, want 0x0", input, g.Width, g.Height)
+		}
+		if got := g.String(); got != "" {
+			t.Errorf("Parse(%q).String() = %q, want it empty", input, got)
+		}
+	}
+}
+
+func TestRowsOfGridWithoutColumns(t *testing.T) {
+	g := New[rune](0, 2)
+	if row := g.Row(1); len(row) != 0 {
+		t.Errorf("Row(1) = %v, want it empty", row)
+	}
+	if got := g.String(); got != "\n\n" {
+		t.Errorf("String() = %q, want two empty lines", got)
+	}
+}
+
+func TestRemap(t *testing.T) {
+	g := Parse("abc\ndef\n")
+
+	tests := map[string]struct {
+		got  *Grid[rune]
+		want string
+	}{
+		"transpose":               {got: g.Transpose(), want: "ad\nbe\ncf\n"},
+		"rotate clockwise":        {got: g.RotateClockwise(), want: "da\neb\nfc\n"},
+		"rotate counterclockwise": {got: g.RotateCounterClockwise(), want: "cf\nbe\nad\n"},
+		"flip horizontal":         {got: g.FlipHorizontal(), want: "cba\nfed\n"},
+		"flip vertical":           {got: g.FlipVertical(), want: "def\nabc\n"},
+		"four turns":              {got: g.RotateClockwise().RotateClockwise().RotateClockwise().RotateClockwise(), want: "abc\ndef\n"},
+		"turn there and back":     {got: g.RotateClockwise().RotateCounterClockwise(), want: "abc\ndef\n"},
+		"transpose twice":         {got: g.Transpose().Transpose(), want: "abc\ndef\n"},
+		"half turn is both flips": {got: g.RotateClockwise().RotateClockwise(), want: g.FlipHorizontal().FlipVertical().String()},
+		"transpose of empty grid": {got: Parse("").Transpose(), want: ""},
+		"rotation of empty grid":  {got: Parse("").RotateClockwise(), want: ""},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.got.String(); got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+
+	// remapping returns a new grid
+	if g.String() != "abc\ndef\n" {
+		t.Errorf("the original grid changed to %q", g.String())
+	}
+}
+
+func TestRowAndColumn(t *testing.T) {
+	g := Parse("abc\ndef\n")
+
+	if got := string(g.Row(1)); got != "def" {
+		t.Errorf("Row(1) = %q, want def", got)
+	}
+	if got := string(g.Column(2)); got != "cf" {
+		t.Errorf("Column(2) = %q, want cf", got)
+	}
+
+	// rows share the cells of the grid
+	g.Row(0)[0] = 'x'
+	if got := g.Get(0, 0); got != 'x' {
+		t.Errorf("Get(0, 0) = %q after changing the row, want x", got)
+	}
+}
+
+func TestFind(t *testing.T) {
+	g := Parse("#.S\n.S#\n")
+
+	cell, ok := g.Find(func(r rune) bool { return r == 'S' })
+	if !ok || cell.X != 2 || cell.Y != 0 {
+		t.Errorf("Find() = %+v, %t; want 2,0", cell, ok)
+	}
+	if cells := g.FindAll(func(r rune) bool { return r == '#' }); len(cells) != 2 || cells[1].X != 2 || cells[1].Y != 1 {
+		t.Errorf("FindAll() = %+v, want 0,0 and 2,1", cells)
+	}
+	if _, ok = Parse("").Find(func(r rune) bool { return true }); ok {
+		t.Error("Find() in an empty grid found a cell")
+	}
+}
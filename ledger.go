@@ -0,0 +1,174 @@
+package advent_of_code
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+const ledgerFile = "ledger.json"
+
+var (
+	ErrAlreadySolved = errors.New("level is already solved")
+	ErrProvablyWrong = errors.New("answer is known to be wrong")
+)
+
+// LedgerEntry records a single submitted answer and its verdict
+type LedgerEntry struct {
+	Level       int       `json:"level"`
+	Answer      string    `json:"answer"`
+	Verdict     Verdict   `json:"verdict"`
+	SubmittedAt time.Time `json:"submittedAt"`
+}
+
+// Ledger is the record of every answer submitted for a day
+type Ledger struct {
+	Entries []LedgerEntry `json:"entries"`
+	// CooldownUntil is when AoC will accept another answer after asking us to wait
+	CooldownUntil time.Time `json:"cooldownUntil"`
+
+	path string
+}
+
+// LoadLedger reads the ledger kept in the given puzzle directory; a missing ledger is returned empty
+func LoadLedger(puzzlePath string) (*Ledger, error) {
+	ledger := &Ledger{
+		path: filepath.Join(puzzlePath, ledgerFile),
+	}
+
+	contents, err := os.ReadFile(ledger.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return ledger, nil
+		}
+		return nil, fmt.Errorf("error reading ledger: %w", err)
+	}
+
+	if err = json.Unmarshal(contents, ledger); err != nil {
+		return nil, fmt.Errorf("error reading ledger: %w", err)
+	}
+
+	return ledger, nil
+}
+
+// Save writes the ledger back to its puzzle directory
+func (l *Ledger) Save() error {
+	contents, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding ledger: %w", err)
+	}
+
+	return WriteFile(l.path, contents, true)
+}
+
+// Record adds a submitted answer and the verdict it received, along with any cooldown the reply asked for
+func (l *Ledger) Record(level int, answer string, result *SubmitResult) {
+	now := time.Now()
+
+	l.Entries = append(l.Entries, LedgerEntry{
+		Level:       level,
+		Answer:      answer,
+		Verdict:     result.Verdict,
+		SubmittedAt: now,
+	})
+
+	if result.Wait > 0 {
+		l.CooldownUntil = now.Add(result.Wait)
+	}
+}
+
+// Accept records an answer that AoC is known to have accepted for the level, unless one is recorded already
+func (l *Ledger) Accept(level int, answer string) {
+	if _, ok := l.Accepted(level); ok {
+		return
+	}
+
+	l.Entries = append(l.Entries, LedgerEntry{
+		Level:       level,
+		Answer:      answer,
+		Verdict:     VerdictCorrect,
+		SubmittedAt: time.Now(),
+	})
+}
+
+// Cooldown returns how much longer AoC has asked us to wait before submitting another answer
+func (l *Ledger) Cooldown() time.Duration {
+	return max(time.Until(l.CooldownUntil), 0)
+}
+
+// Accepted returns the answer that AoC accepted for the level, if any
+func (l *Ledger) Accepted(level int) (string, bool) {
+	for _, entry := range l.Entries {
+		if entry.Level == level && entry.Verdict == VerdictCorrect {
+			return entry.Answer, true
+		}
+	}
+
+	return "", false
+}
+
+// Bounds returns the known limits of a numeric answer for the level
+//
+// low is the highest answer that was too low and high the lowest answer that was too high; ok values report whether
+// each bound is known
+func (l *Ledger) Bounds(level int) (low int64, lowOk bool, high int64, highOk bool) {
+	for _, entry := range l.Entries {
+		if entry.Level != level {
+			continue
+		}
+		value, err := strconv.ParseInt(entry.Answer, 10, 64)
+		if err != nil {
+			continue
+		}
+		switch entry.Verdict {
+		case VerdictTooLow:
+			if !lowOk || value > low {
+				low, lowOk = value, true
+			}
+		case VerdictTooHigh:
+			if !highOk || value < high {
+				high, highOk = value, true
+			}
+		}
+	}
+
+	return
+}
+
+// Check refuses answers for the level that are already known to be wrong, and any answer for a solved level
+func (l *Ledger) Check(level int, answer string) error {
+	for _, entry := range l.Entries {
+		if entry.Level != level {
+			continue
+		}
+		switch entry.Verdict {
+		case VerdictCorrect:
+			return fmt.Errorf("%w: %s was accepted on %s", ErrAlreadySolved, entry.Answer,
+				entry.SubmittedAt.Format(time.DateTime))
+		case VerdictWrong, VerdictTooHigh, VerdictTooLow:
+			if entry.Answer == answer {
+				return fmt.Errorf("%w: %s was already rejected as %s", ErrProvablyWrong, answer, entry.Verdict)
+			}
+		}
+	}
+
+	value, err := strconv.ParseInt(answer, 10, 64)
+	if err != nil {
+		// only numeric answers have bounds
+		return nil
+	}
+
+	low, lowOk, high, highOk := l.Bounds(level)
+	if lowOk && value <= low {
+		return fmt.Errorf("%w: %d is not above %d, which was too low", ErrProvablyWrong, value, low)
+	}
+	if highOk && value >= high {
+		return fmt.Errorf("%w: %d is not below %d, which was too high", ErrProvablyWrong, value, high)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package advent_of_code
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLedgerCheck(t *testing.T) {
+	ledger := &Ledger{}
+	ledger.Record(1, "100", &SubmitResult{Verdict: VerdictTooLow})
+	ledger.Record(1, "150", &SubmitResult{Verdict: VerdictTooLow})
+	ledger.Record(1, "400", &SubmitResult{Verdict: VerdictTooHigh})
+	ledger.Record(1, "300", &SubmitResult{Verdict: VerdictTooHigh})
+	ledger.Record(1, "200", &SubmitResult{Verdict: VerdictWrong})
+	ledger.Record(1, "abc", &SubmitResult{Verdict: VerdictWrong})
+	ledger.Record(1, "250", &SubmitResult{Verdict: VerdictRateLimited})
+
+	tests := map[string]struct {
+		level  int
+		answer string
+		want   error
+	}{
+		"below the low bound":         {level: 1, answer: "120", want: ErrProvablyWrong},
+		"at the low bound":            {level: 1, answer: "150", want: ErrProvablyWrong},
+		"above the high bound":        {level: 1, answer: "350", want: ErrProvablyWrong},
+		"at the high bound":           {level: 1, answer: "300", want: ErrProvablyWrong},
+		"repeated wrong answer":       {level: 1, answer: "200", want: ErrProvablyWrong},
+		"repeated text answer":        {level: 1, answer: "abc", want: ErrProvablyWrong},
+		"between the bounds":          {level: 1, answer: "151"},
+		"rate limited answer":         {level: 1, answer: "250"},
+		"new text answer":             {level: 1, answer: "abd"},
+		"other level ignores bounds":  {level: 2, answer: "100"},
+		"other level ignores repeats": {level: 2, answer: "abc"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if err := ledger.Check(tc.level, tc.answer); !errors.Is(err, tc.want) {
+				t.Errorf("Check(%d, %q) error = %v, want %v", tc.level, tc.answer, err, tc.want)
+			}
+		})
+	}
+}
+
+func TestLedgerBounds(t *testing.T) {
+	ledger := &Ledger{}
+	if _, lowOk, _, highOk := ledger.Bounds(1); lowOk || highOk {
+		t.Fatalf("Bounds() of an empty ledger reports bounds")
+	}
+
+	ledger.Record(1, "100", &SubmitResult{Verdict: VerdictTooLow})
+	ledger.Record(1, "150", &SubmitResult{Verdict: VerdictTooLow})
+	ledger.Record(1, "400", &SubmitResult{Verdict: VerdictTooHigh})
+	ledger.Record(1, "300", &SubmitResult{Verdict: VerdictTooHigh})
+	ledger.Record(1, "120", &SubmitResult{Verdict: VerdictTooLow})
+	ledger.Record(2, "500", &SubmitResult{Verdict: VerdictTooLow})
+
+	low, lowOk, high, highOk := ledger.Bounds(1)
+	if !lowOk || low != 150 || !highOk || high != 300 {
+		t.Errorf("Bounds(1) = %d, %t, %d, %t; want 150, true, 300, true", low, lowOk, high, highOk)
+	}
+}
+
+func TestLedgerRefusesSolvedLevels(t *testing.T) {
+	ledger := &Ledger{}
+	ledger.Record(1, "142", &SubmitResult{Verdict: VerdictCorrect})
+
+	if err := ledger.Check(1, "143"); !errors.Is(err, ErrAlreadySolved) {
+		t.Errorf("Check() error = %v, want %v", err, ErrAlreadySolved)
+	}
+	if answer, ok := ledger.Accepted(1); !ok || answer != "142" {
+		t.Errorf("Accepted(1) = %q, %t; want 142, true", answer, ok)
+	}
+	if err := ledger.Check(2, "143"); err != nil {
+		t.Errorf("Check() of the unsolved level error = %v", err)
+	}
+}
+
+func TestLedgerSaveAndLoad(t *testing.T) {
+	dir := t.TempDir()
+
+	ledger, err := LoadLedger(dir)
+	if err != nil {
+		t.Fatalf("LoadLedger() of a missing ledger error = %v", err)
+	}
+	ledger.Record(1, "100", &SubmitResult{Verdict: VerdictTooLow, Wait: time.Minute})
+	if err = ledger.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := LoadLedger(dir)
+	if err != nil {
+		t.Fatalf("LoadLedger() error = %v", err)
+	}
+	if len(loaded.Entries) != 1 || loaded.Entries[0].Verdict != VerdictTooLow || loaded.Entries[0].Answer != "100" {
+		t.Errorf("loaded entries = %+v, want the recorded too low answer", loaded.Entries)
+	}
+	if loaded.Cooldown() <= 0 {
+		t.Errorf("Cooldown() = %s, want the recorded wait", loaded.Cooldown())
+	}
+}
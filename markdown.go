@@ -0,0 +1,180 @@
+package advent_of_code
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+var (
+	dayHeadingRe  = regexp.MustCompile(`^---\s*(Day \d+:.*?)\s*---$`)
+	partHeadingRe = regexp.MustCompile(`^---\s*(Part .*?)\s*---$`)
+	whitespaceRe  = regexp.MustCompile(`\s+`)
+	escaper       = strings.NewReplacer(`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`", "[", `\[`, "]", `\]`)
+	// markers that start a heading, list, quote or rule when they begin a line
+	blockMarkerRe = regexp.MustCompile(`^ *(?:[-+=#>]|\d+[.)])`)
+)
+
+// PuzzleMarkdown converts the .day-desc articles of a puzzle page into Markdown
+//
+// Relative links are resolved against pageURL
+func PuzzleMarkdown(descriptions *goquery.Selection, pageURL *url.URL) []byte {
+	c := &markdownConverter{
+		pageURL: pageURL,
+	}
+
+	descriptions.Each(func(_ int, article *goquery.Selection) {
+		c.blocks(article, 0)
+	})
+
+	return []byte(strings.TrimRight(c.buf.String(), "\n") + "\n")
+}
+
+type markdownConverter struct {
+	pageURL *url.URL
+	buf     strings.Builder
+}
+
+// blocks renders the children of s as Markdown blocks; depth is the list nesting level
+func (c *markdownConverter) blocks(s *goquery.Selection, depth int) {
+	s.Contents().Each(func(_ int, child *goquery.Selection) {
+		switch goquery.NodeName(child) {
+		case "h2":
+			c.heading(strings.TrimSpace(child.Text()))
+		case "p":
+			c.paragraph(c.inline(child))
+		case "pre":
+			c.codeBlock(child.Text())
+		case "ul", "ol":
+			c.list(child, depth)
+			if depth == 0 {
+				c.buf.WriteString("\n")
+			}
+		case "#text", "#comment":
+			// whitespace between blocks
+		default:
+			c.paragraph(c.inline(child))
+		}
+	})
+}
+
+func (c *markdownConverter) heading(text string) {
+	// AoC headings look like "--- Day 1: Trebuchet?! ---" and "--- Part Two ---"
+	if matches := dayHeadingRe.FindStringSubmatch(text); matches != nil {
+		c.buf.WriteString("# " + matches[1] + "\n\n## Part One\n\n")
+		return
+	}
+	if matches := partHeadingRe.FindStringSubmatch(text); matches != nil {
+		c.buf.WriteString("## " + matches[1] + "\n\n")
+		return
+	}
+
+	c.buf.WriteString("## " + text + "\n\n")
+}
+
+func (c *markdownConverter) paragraph(text string) {
+	if text = strings.TrimSpace(text); text != "" {
+		c.buf.WriteString(escapeBlockMarkers(text) + "\n\n")
+	}
+}
+
+func (c *markdownConverter) codeBlock(code string) {
+	if !strings.HasSuffix(code, "\n") {
+		code += "\n"
+	}
+
+	c.buf.WriteString("```\n" + code + "```\n\n")
+}
+
+func (c *markdownConverter) list(s *goquery.Selection, depth int) {
+	indent := strings.Repeat("  ", depth)
+	ordered := goquery.NodeName(s) == "ol"
+
+	s.ChildrenFiltered("li").Each(func(i int, item *goquery.Selection) {
+		marker := "- "
+		if ordered {
+			marker = fmt.Sprintf("%d. ", i+1)
+		}
+
+		// nested lists are rendered after the text of the item
+		nested := item.ChildrenFiltered("ul, ol")
+		text := c.inline(item.Clone().ChildrenFiltered("ul, ol").Remove().End())
+		c.buf.WriteString(indent + marker + escapeBlockMarkers(strings.TrimSpace(text)) + "\n")
+
+		nested.Each(func(_ int, list *goquery.Selection) {
+			c.list(list, depth+1)
+		})
+	})
+}
+
+// inline renders the contents of s as a single line of Markdown
+func (c *markdownConverter) inline(s *goquery.Selection) string {
+	buf := strings.Builder{}
+
+	s.Contents().Each(func(_ int, child *goquery.Selection) {
+		switch goquery.NodeName(child) {
+		case "#text":
+			buf.WriteString(escaper.Replace(whitespaceRe.ReplaceAllString(child.Text(), " ")))
+		case "em", "strong", "b":
+			// AoC uses emphasis for the important numbers and words, which read best in bold
+			if code := child.ChildrenFiltered("code"); code.Length() > 0 && strings.TrimSpace(child.Text()) == strings.TrimSpace(code.Text()) {
+				buf.WriteString("**" + inlineCode(code.Text()) + "**")
+			} else if text := c.inline(child); strings.TrimSpace(text) != "" {
+				buf.WriteString("**" + strings.TrimSpace(text) + "**")
+			}
+		case "code":
+			// emphasis can't live inside a code span, so it moves outside
+			if child.Find("em").Length() > 0 {
+				buf.WriteString("**" + inlineCode(child.Text()) + "**")
+			} else {
+				buf.WriteString(inlineCode(child.Text()))
+			}
+		case "a":
+			buf.WriteString("[" + strings.TrimSpace(c.inline(child)) + "](" + c.link(child.AttrOr("href", "")) + ")")
+		case "br":
+			buf.WriteString("  \n")
+		default:
+			buf.WriteString(c.inline(child))
+		}
+	})
+
+	return buf.String()
+}
+
+func (c *markdownConverter) link(href string) string {
+	ref, err := url.Parse(href)
+	if err != nil || c.pageURL == nil {
+		return href
+	}
+
+	return c.pageURL.ResolveReference(ref).String()
+}
+
+// escapeBlockMarkers escapes the start of each line of text so that prose such as "- 3" or "1. then" stays prose
+func escapeBlockMarkers(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if marker := blockMarkerRe.FindString(line); marker != "" {
+			// the escape goes right before the last character, which is the marker or the dot after a number
+			lines[i] = marker[:len(marker)-1] + `\` + line[len(marker)-1:]
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// inlineCode wraps text in enough backticks that backticks inside it survive
+func inlineCode(text string) string {
+	fence := "`"
+	for strings.Contains(text, fence) {
+		fence += "`"
+	}
+	if strings.HasPrefix(text, "`") || strings.HasSuffix(text, "`") {
+		text = " " + text + " "
+	}
+
+	return fence + text + fence
+}
@@ -0,0 +1,128 @@
+package advent_of_code
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestPuzzleMarkdown(t *testing.T) {
+	tests := map[string]struct {
+		html string
+		want string
+	}{
+		"day heading": {
+			html: `<h2>--- Day 1: Trebuchet?! ---</h2>`,
+			want: "# Day 1: Trebuchet?!\n\n## Part One\n",
+		},
+		"part heading": {
+			html: `<h2 id="part2">--- Part Two ---</h2>`,
+			want: "## Part Two\n",
+		},
+		"paragraph whitespace": {
+			html: "<p>The newly-improved\n   calibration   document.</p>",
+			want: "The newly-improved calibration document.\n",
+		},
+		"emphasis": {
+			html: `<p>Sum them to get <em>a total</em>.</p>`,
+			want: "Sum them to get **a total**.\n",
+		},
+		"emphasized code": {
+			html: `<p>The totals are <code><em>142</em></code> and <em><code>281</code></em>.</p>`,
+			want: "The totals are **`142`** and **`281`**.\n",
+		},
+		"code with emphasis inside": {
+			html: `<p>Look at <code>1<em>2</em>3</code>.</p>`,
+			want: "Look at **`123`**.\n",
+		},
+		"code with backticks": {
+			html: "<p>Run <code>a`b</code> and <code>`c</code>.</p>",
+			want: "Run ``a`b`` and `` `c ``.\n",
+		},
+		"relative link": {
+			html: `<p>See the <a href="/2023/about">about page</a> or <a href="2">day 2</a>.</p>`,
+			want: "See the [about page](https://adventofcode.com/2023/about) or [day 2](https://adventofcode.com/2023/day/2).\n",
+		},
+		"absolute link": {
+			html: `<p>Ask on <a href="https://www.reddit.com/r/adventofcode/">the subreddit</a>.</p>`,
+			want: "Ask on [the subreddit](https://www.reddit.com/r/adventofcode/).\n",
+		},
+		"code block": {
+			html: "<pre><code>1abc2\n*x_y*\n</code></pre>",
+			want: "```\n1abc2\n*x_y*\n```\n",
+		},
+		"code block without a final newline": {
+			html: "<pre><code>1abc2</code></pre>",
+			want: "```\n1abc2\n```\n",
+		},
+		"nested list": {
+			html: `<ul><li>The first <em>item</em><ul><li>inner one</li><li>inner two</li></ul></li><li>The second</li></ul>`,
+			want: "- The first **item**\n  - inner one\n  - inner two\n- The second\n",
+		},
+		"ordered list": {
+			html: `<ol><li>one</li><li>two</li></ol>`,
+			want: "1. one\n2. two\n",
+		},
+		"line break": {
+			html: `<p>one<br/>two</p>`,
+			want: "one  \ntwo\n",
+		},
+		"markdown characters in text": {
+			html: `<p>Tiles like * and _ and [x] or a \ or a ` + "`" + `.</p>`,
+			want: "Tiles like \\* and \\_ and \\[x\\] or a \\\\ or a \\`.\n",
+		},
+		"heading marker at the start": {
+			html: `<p># marks a rock.</p>`,
+			want: "\\# marks a rock.\n",
+		},
+		"list marker at the start": {
+			html: `<p>- 3 is the difference.</p>`,
+			want: "\\- 3 is the difference.\n",
+		},
+		"number at the start": {
+			html: `<p>1. Then the next step.</p>`,
+			want: "1\\. Then the next step.\n",
+		},
+		"marker after a line break": {
+			html: `<p>one<br/> - two</p>`,
+			want: "one  \n \\- two\n",
+		},
+		"marker in a list item": {
+			html: `<ul><li>+ 4 is added</li></ul>`,
+			want: "- \\+ 4 is added\n",
+		},
+		"markers inside a line": {
+			html: `<p>Count the # tiles - all 3.</p>`,
+			want: "Count the # tiles - all 3.\n",
+		},
+	}
+	pageURL, err := url.Parse("https://adventofcode.com/2023/day/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := string(PuzzleMarkdown(descriptions(t, tc.html), pageURL)); got != tc.want {
+				t.Errorf("PuzzleMarkdown() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPuzzleMarkdownArticles(t *testing.T) {
+	pageURL, err := url.Parse("https://adventofcode.com/2023/day/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := string(PuzzleMarkdown(descriptions(t,
+		`<h2>--- Day 1: Trebuchet?! ---</h2><p>For example:</p><pre><code>1abc2
+</code></pre><p>What is the sum?</p>`,
+		`<h2 id="part2">--- Part Two ---</h2><p>What is the new sum?</p>`,
+	), pageURL))
+
+	want := "# Day 1: Trebuchet?!\n\n## Part One\n\nFor example:\n\n```\n1abc2\n```\n\nWhat is the sum?\n\n" +
+		"## Part Two\n\nWhat is the new sum?\n"
+	if got != want {
+		t.Errorf("PuzzleMarkdown() = %q, want %q", got, want)
+	}
+}
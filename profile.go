@@ -0,0 +1,96 @@
+package advent_of_code
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// DefaultProfile is the profile used when none is chosen; it keeps its files directly in the puzzle directory
+const DefaultProfile Profile = "default"
+
+const profilesDir = "profiles"
+
+var (
+	ErrInvalidProfile = errors.New("invalid profile name")
+
+	profileNameRe = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`)
+)
+
+// Profile names an AoC account; each profile has its own session, input, solutions, replies and ledger
+type Profile string
+
+// ParseProfile checks that name can be used as a profile name; an empty name is the default profile
+func ParseProfile(name string) (Profile, error) {
+	if name == "" {
+		return DefaultProfile, nil
+	}
+	if !profileNameRe.MatchString(name) {
+		return "", fmt.Errorf("%w: %q; use letters, digits, '-' and '_'", ErrInvalidProfile, name)
+	}
+
+	return Profile(name), nil
+}
+
+// SessionEnv returns the environment variable holding the session cookie of the profile: AOC_SESSION for the
+// default profile and AOC_SESSION_<NAME> for the others
+func (p Profile) SessionEnv() string {
+	if p == DefaultProfile {
+		return "AOC_SESSION"
+	}
+
+	return "AOC_SESSION_" + strings.ToUpper(strings.ReplaceAll(string(p), "-", "_"))
+}
+
+// Dir returns the directory within the puzzle directory that holds the files of the profile
+func (p Profile) Dir(puzzlePath string) string {
+	if p == DefaultProfile {
+		return puzzlePath
+	}
+
+	return filepath.Join(puzzlePath, profilesDir, string(p))
+}
+
+// CacheDir returns the directory that caches the downloads made with the session of the profile
+func (p Profile) CacheDir() (string, error) {
+	dir, err := DefaultCacheDir()
+	if err != nil {
+		return "", err
+	}
+	if p == DefaultProfile {
+		return dir, nil
+	}
+
+	return filepath.Join(dir, profilesDir, string(p)), nil
+}
+
+// FindProfiles returns the profiles that have an input in the puzzle directory, the default profile first
+func FindProfiles(puzzlePath string) ([]Profile, error) {
+	var profiles []Profile
+	if _, err := os.Stat(filepath.Join(puzzlePath, "input.txt")); err == nil {
+		profiles = append(profiles, DefaultProfile)
+	}
+
+	entries, err := os.ReadDir(filepath.Join(puzzlePath, profilesDir))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return profiles, nil
+		}
+		return nil, fmt.Errorf("error reading profiles: %w", err)
+	}
+
+	for _, entry := range entries {
+		profile, err := ParseProfile(entry.Name())
+		if !entry.IsDir() || err != nil || profile == DefaultProfile {
+			continue
+		}
+		if _, err = os.Stat(filepath.Join(profile.Dir(puzzlePath), "input.txt")); err == nil {
+			profiles = append(profiles, profile)
+		}
+	}
+
+	return profiles, nil
+}
@@ -0,0 +1,115 @@
+package advent_of_code
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestParseProfile(t *testing.T) {
+	tests := map[string]struct {
+		name string
+		want Profile
+		err  error
+	}{
+		"empty":               {name: "", want: DefaultProfile},
+		"default":             {name: "default", want: DefaultProfile},
+		"letters and digits":  {name: "alice2", want: "alice2"},
+		"dash and underscore": {name: "work-account_2", want: "work-account_2"},
+		"leading dash":        {name: "-alice", err: ErrInvalidProfile},
+		"path":                {name: "../alice", err: ErrInvalidProfile},
+		"space":               {name: "al ice", err: ErrInvalidProfile},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseProfile(tc.name)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("ParseProfile(%q) error = %v, want %v", tc.name, err, tc.err)
+			}
+			if got != tc.want {
+				t.Errorf("ParseProfile(%q) = %q, want %q", tc.name, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestProfileSessionEnv(t *testing.T) {
+	tests := map[Profile]string{
+		DefaultProfile:   "AOC_SESSION",
+		"alice":          "AOC_SESSION_ALICE",
+		"work-account_2": "AOC_SESSION_WORK_ACCOUNT_2",
+	}
+	for profile, want := range tests {
+		if got := profile.SessionEnv(); got != want {
+			t.Errorf("%s.SessionEnv() = %q, want %q", profile, got, want)
+		}
+	}
+}
+
+func TestProfileDirs(t *testing.T) {
+	cacheDir := t.TempDir()
+	t.Setenv("AOC_CACHE_DIR", cacheDir)
+
+	puzzlePath := filepath.Join("2023", "day-01")
+	tests := map[Profile]struct {
+		dir      string
+		cacheDir string
+	}{
+		DefaultProfile: {dir: puzzlePath, cacheDir: cacheDir},
+		"alice": {
+			dir:      filepath.Join(puzzlePath, "profiles", "alice"),
+			cacheDir: filepath.Join(cacheDir, "profiles", "alice"),
+		},
+	}
+	for profile, tc := range tests {
+		if got := profile.Dir(puzzlePath); got != tc.dir {
+			t.Errorf("%s.Dir() = %q, want %q", profile, got, tc.dir)
+		}
+		if got, err := profile.CacheDir(); err != nil || got != tc.cacheDir {
+			t.Errorf("%s.CacheDir() = %q, %v; want %q", profile, got, err, tc.cacheDir)
+		}
+	}
+}
+
+func TestFindProfiles(t *testing.T) {
+	puzzlePath := t.TempDir()
+
+	profiles, err := FindProfiles(puzzlePath)
+	if err != nil || len(profiles) != 0 {
+		t.Fatalf("FindProfiles() of an empty directory = %v, %v; want none", profiles, err)
+	}
+
+	for _, path := range []string{
+		"input.txt",
+		filepath.Join("profiles", "bob", "input.txt"),
+		filepath.Join("profiles", "alice", "input.txt"),
+		// profiles without an input, with invalid names or that aren't directories are left out
+		filepath.Join("profiles", "carol", "solution-1.txt"),
+		filepath.Join("profiles", "-dave", "input.txt"),
+		filepath.Join("profiles", "default", "input.txt"),
+		filepath.Join("profiles", "notes.txt"),
+	} {
+		if err = WriteFile(filepath.Join(puzzlePath, path), []byte("1\n"), true); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+	}
+
+	profiles, err = FindProfiles(puzzlePath)
+	if err != nil {
+		t.Fatalf("FindProfiles() error = %v", err)
+	}
+	if want := []Profile{DefaultProfile, "alice", "bob"}; !slices.Equal(profiles, want) {
+		t.Errorf("FindProfiles() = %v, want %v", profiles, want)
+	}
+
+	// named profiles are found without a default input too
+	if err = os.Remove(filepath.Join(puzzlePath, "input.txt")); err != nil {
+		t.Fatal(err)
+	}
+	profiles, err = FindProfiles(puzzlePath)
+	if want := []Profile{"alice", "bob"}; err != nil || !slices.Equal(profiles, want) {
+		t.Errorf("FindProfiles() = %v, %v; want %v", profiles, err, want)
+	}
+}
@@ -0,0 +1,72 @@
+package advent_of_code
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const progressFile = "progress.json"
+
+// Progress records the stars earned for each day, keyed by year and then day
+type Progress struct {
+	Stars map[int]map[int]int `json:"stars"`
+
+	path string
+}
+
+// LoadProgress reads the progress record of the profile kept alongside its cache; a missing record is returned empty
+func LoadProgress(profile Profile) (*Progress, error) {
+	dir, err := profile.CacheDir()
+	if err != nil {
+		return nil, err
+	}
+
+	progress := &Progress{
+		Stars: map[int]map[int]int{},
+		path:  filepath.Join(dir, progressFile),
+	}
+
+	contents, err := os.ReadFile(progress.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return progress, nil
+		}
+		return nil, fmt.Errorf("error reading progress: %w", err)
+	}
+
+	if err = json.Unmarshal(contents, progress); err != nil {
+		return nil, fmt.Errorf("error reading progress: %w", err)
+	}
+	if progress.Stars == nil {
+		progress.Stars = map[int]map[int]int{}
+	}
+
+	return progress, nil
+}
+
+// Save writes the progress record back to disk
+func (p *Progress) Save() error {
+	contents, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding progress: %w", err)
+	}
+
+	return WriteFile(p.path, contents, true)
+}
+
+// Get returns the number of stars earned for the given day and year
+func (p *Progress) Get(day, year int) int {
+	return p.Stars[year][day]
+}
+
+// Earn records that the given level of the puzzle has been solved; stars are never taken away
+func (p *Progress) Earn(day, year, level int) {
+	if p.Stars[year] == nil {
+		p.Stars[year] = map[int]int{}
+	}
+
+	p.Stars[year][day] = max(p.Stars[year][day], level)
+}
@@ -0,0 +1,99 @@
+package advent_of_code
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+const puzzleFile = "puzzle.md"
+
+// PuzzlePage is the useful content of a puzzle page
+type PuzzlePage struct {
+	Markdown []byte
+	Examples *Examples
+	// Answers are the accepted answers that the page shows once a level is solved, in level order
+	Answers []string
+}
+
+// GetPuzzle fetches the puzzle page for the given day and year, converts the puzzle description to Markdown and
+// extracts the examples from it
+func GetPuzzle(client *Client, day, year int) (*PuzzlePage, error) {
+	body, err := client.Puzzle(day, year)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse the page with goquery
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	descriptions := doc.Find(".day-desc")
+	if descriptions.Length() == 0 {
+		return nil, fmt.Errorf("no puzzle description found in the page")
+	}
+
+	pageURL, err := url.Parse(fmt.Sprintf("%s/%d/day/%d", strings.TrimRight(client.BaseURL, "/"), year, day))
+	if err != nil {
+		return nil, err
+	}
+
+	return &PuzzlePage{
+		Markdown: PuzzleMarkdown(descriptions, pageURL),
+		Examples: ExtractExamples(descriptions),
+		Answers:  puzzleAnswers(doc),
+	}, nil
+}
+
+// puzzleAnswers finds the "Your puzzle answer was" paragraphs that follow each solved level
+func puzzleAnswers(doc *goquery.Document) []string {
+	var answers []string
+	doc.Find("main p").Each(func(_ int, p *goquery.Selection) {
+		if !strings.HasPrefix(strings.TrimSpace(p.Text()), "Your puzzle answer was") {
+			return
+		}
+		if answer := strings.TrimSpace(p.Find("code").First().Text()); answer != "" {
+			answers = append(answers, answer)
+		}
+	})
+
+	return answers
+}
+
+// WritePuzzle fetches the puzzle description for the given day and year and writes it, along with the examples,
+// into the puzzle directory
+//
+// Answers that the page shows as accepted are recorded in the ledger kept in profilePath, so that days solved before
+// the ledger existed can be checked too
+func WritePuzzle(client *Client, day, year int, puzzlePath, profilePath string) error {
+	puzzle, err := GetPuzzle(client, day, year)
+	if err != nil {
+		return err
+	}
+
+	if len(puzzle.Answers) > 0 {
+		ledger, err := LoadLedger(profilePath)
+		if err != nil {
+			return err
+		}
+		for i, answer := range puzzle.Answers {
+			ledger.Accept(i+1, answer)
+		}
+		if err = ledger.Save(); err != nil {
+			return err
+		}
+	}
+
+	err = WriteFile(filepath.Join(puzzlePath, puzzleFile), puzzle.Markdown, true)
+	if err != nil {
+		return fmt.Errorf("error writing puzzle: %w", err)
+	}
+
+	return puzzle.Examples.Write(puzzlePath)
+}
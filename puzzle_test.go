@@ -0,0 +1,79 @@
+package advent_of_code
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// solvedPage is a puzzle page with both levels solved, trimmed down to the parts that are read
+const solvedPage = `<html><body><main>
+<article class="day-desc"><h2>--- Day 1: Trebuchet?! ---</h2>
+<p>For example:</p>
+<pre><code>1abc2
+pqr3stu8vwx
+</code></pre>
+<p>In this example, adding these together produces <code><em>142</em></code>.</p>
+</article>
+<p>Your puzzle answer was <code>54304</code>.</p>
+<article class="day-desc"><h2 id="part2">--- Part Two ---</h2>
+<p>What is the sum of all of the calibration values?</p>
+</article>
+<p>Your puzzle answer was <code>54418</code>.</p>
+<p class="day-success">Both parts of this puzzle are complete! They provide two gold stars: **</p>
+</main></body></html>`
+
+func TestWritePuzzleRecordsAcceptedAnswers(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(solvedPage))
+	})
+	puzzlePath := t.TempDir()
+	profilePath := filepath.Join(puzzlePath, "profiles", "alt")
+
+	// an answer already in the ledger is kept
+	ledger, err := LoadLedger(profilePath)
+	if err != nil {
+		t.Fatalf("LoadLedger() error = %v", err)
+	}
+	ledger.Record(1, "54304", &SubmitResult{Verdict: VerdictCorrect})
+	if err = ledger.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	if err = WritePuzzle(client, 1, 2023, puzzlePath, profilePath); err != nil {
+		t.Fatalf("WritePuzzle() error = %v", err)
+	}
+
+	if _, err = os.Stat(filepath.Join(puzzlePath, puzzleFile)); err != nil {
+		t.Errorf("puzzle description was not written: %v", err)
+	}
+
+	ledger, err = LoadLedger(profilePath)
+	if err != nil {
+		t.Fatalf("LoadLedger() error = %v", err)
+	}
+	if len(ledger.Entries) != 2 {
+		t.Errorf("ledger entries = %+v, want one per level", ledger.Entries)
+	}
+	for level, want := range map[int]string{1: "54304", 2: "54418"} {
+		if answer, ok := ledger.Accepted(level); !ok || answer != want {
+			t.Errorf("Accepted(%d) = %q, %t; want %s, true", level, answer, ok, want)
+		}
+	}
+}
+
+func TestWritePuzzleWithoutAnswers(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<html><body><main><article class="day-desc"><h2>--- Day 1 ---</h2>
+<p>What is the sum?</p></article><p>Answer: <input type="text" name="answer"/></p></main></body></html>`))
+	})
+	puzzlePath := t.TempDir()
+
+	if err := WritePuzzle(client, 1, 2023, puzzlePath, puzzlePath); err != nil {
+		t.Fatalf("WritePuzzle() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(puzzlePath, ledgerFile)); !os.IsNotExist(err) {
+		t.Errorf("ledger was written for an unsolved puzzle: %v", err)
+	}
+}
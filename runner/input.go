@@ -0,0 +1,49 @@
+package runner
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	. "github.com/stackus/advent-of-code"
+)
+
+const inputFile = "input.txt"
+
+type puzzleInput struct {
+	// path is the file the input was read from; empty for stdin
+	path     string
+	contents string
+}
+
+// loadInput reads the input chosen by the -input and -example flags, falling back to the input.txt of the profile
+func loadInput(puzzlePath, profilePath, inputPath string, example int) (*puzzleInput, error) {
+	switch {
+	case inputPath != "" && example != 0:
+		return nil, errors.New("-input and -example can't be used together")
+	case inputPath == "-":
+		contents, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, fmt.Errorf("error reading stdin: %w", err)
+		}
+		return &puzzleInput{contents: string(contents)}, nil
+	case example < 0:
+		return nil, fmt.Errorf("invalid example number: %d", example)
+	case example > 0:
+		inputPath = filepath.Join(puzzlePath, ExampleFile(example))
+	case inputPath == "":
+		inputPath = filepath.Join(profilePath, inputFile)
+	}
+
+	contents, err := os.ReadFile(inputPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) && inputPath == filepath.Join(profilePath, inputFile) {
+			return nil, fmt.Errorf("%w; download it with aoc fetch, or choose another input with -input or -example", err)
+		}
+		return nil, err
+	}
+
+	return &puzzleInput{path: inputPath, contents: string(contents)}, nil
+}
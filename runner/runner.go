@@ -0,0 +1,104 @@
+package runner
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	. "github.com/stackus/advent-of-code"
+)
+
+// Puzzle identifies the day being run
+type Puzzle struct {
+	Day  int
+	Year int
+}
+
+// Answer is any type a puzzle can be answered with
+type Answer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~string
+}
+
+// FormatAnswer formats an answer the way it is written to the solution file and submitted: integers in base 10 and
+// strings without surrounding whitespace
+func FormatAnswer[A Answer](answer A) string {
+	value := reflect.ValueOf(answer)
+
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10)
+	default:
+		return strings.TrimSpace(value.String())
+	}
+}
+
+// Raw passes the input through untouched, for solutions that parse the input themselves
+func Raw(input string) string {
+	return input
+}
+
+// Run handles everything around a day's solution: it parses the flags, loads, trims and parses the input, runs the
+// requested part with timing and writes the solution file
+//
+// The input is read from the input.txt of the -profile unless -input or -example chooses another one. The solution
+// file is only written for input.txt, so that it always holds the answer to submit, and goes next to it in the puzzle
+// directory under the repository root; see FindRoot and Profile.Dir. Each part may answer with its own type, integer
+// or string
+func Run[T any, A1, A2 Answer](puzzle Puzzle, parse func(input string) T, part1 func(T) A1, part2 func(T) A2) {
+	var level, example int
+	var inputPath, profileName string
+	flag.IntVar(&level, "puzzle", 1, "puzzle number: 1 or 2")
+	flag.StringVar(&profileName, "profile", os.Getenv("AOC_PROFILE"), "account profile whose input.txt and solution files are used")
+	flag.StringVar(&inputPath, "input", "", "file to read the input from, or - for stdin (default: input.txt)")
+	flag.IntVar(&example, "example", 0, "run on example-N.txt from the puzzle description instead of the input")
+	flag.Parse()
+
+	// check puzzle is valid
+	if level < 1 || level > 2 {
+		log.Fatalf("Invalid puzzle number: %d", level)
+	}
+
+	profile, err := ParseProfile(profileName)
+	if err != nil {
+		log.Fatalf("Invalid profile: %v", err)
+	}
+
+	// examples belong to the puzzle while inputs and solutions belong to the profile
+	puzzlePath := GetPuzzlePath(puzzle.Day, puzzle.Year)
+	profilePath := profile.Dir(puzzlePath)
+
+	input, err := loadInput(puzzlePath, profilePath, inputPath, example)
+	if err != nil {
+		log.Fatalf("Error loading input: %v", err)
+	}
+
+	part := func(parsed T) string { return FormatAnswer(part1(parsed)) }
+	if level == 2 {
+		part = func(parsed T) string { return FormatAnswer(part2(parsed)) }
+	}
+
+	fmt.Println("Running puzzle", level, "for day", puzzle.Day, "and year", puzzle.Year)
+
+	started := time.Now()
+	solution := part(parse(strings.TrimRight(input.contents, "\n")))
+	fmt.Println("Completed in", time.Since(started))
+
+	if input.path == filepath.Join(profilePath, inputFile) {
+		solutionPath := filepath.Join(profilePath, fmt.Sprintf("solution-%d.txt", level))
+		err = WriteFile(solutionPath, []byte(solution), true)
+		if err != nil {
+			log.Fatalf("Error writing solution: %v", err)
+		}
+	}
+	fmt.Println("Solution:", solution)
+}
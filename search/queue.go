@@ -0,0 +1,70 @@
+package search
+
+// PriorityQueue is a min-heap of values ordered by priority; values with the lowest priority come out first
+//
+// The zero value is an empty queue ready to use
+type PriorityQueue[T any] struct {
+	items []queueItem[T]
+}
+
+type queueItem[T any] struct {
+	value    T
+	priority int
+}
+
+// Len returns the number of values in the queue
+func (q *PriorityQueue[T]) Len() int {
+	return len(q.items)
+}
+
+// Push adds a value to the queue
+func (q *PriorityQueue[T]) Push(value T, priority int) {
+	q.items = append(q.items, queueItem[T]{value: value, priority: priority})
+	q.up(len(q.items) - 1)
+}
+
+// Peek returns the value with the lowest priority without removing it; it panics when the queue is empty
+func (q *PriorityQueue[T]) Peek() (T, int) {
+	return q.items[0].value, q.items[0].priority
+}
+
+// Pop removes and returns the value with the lowest priority; it panics when the queue is empty
+func (q *PriorityQueue[T]) Pop() (T, int) {
+	top := q.items[0]
+
+	last := len(q.items) - 1
+	q.items[0] = q.items[last]
+	// clear the moved item so the value can be collected
+	q.items[last] = queueItem[T]{}
+	q.items = q.items[:last]
+	q.down(0)
+
+	return top.value, top.priority
+}
+
+func (q *PriorityQueue[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if q.items[parent].priority <= q.items[i].priority {
+			return
+		}
+		q.items[parent], q.items[i] = q.items[i], q.items[parent]
+		i = parent
+	}
+}
+
+func (q *PriorityQueue[T]) down(i int) {
+	for {
+		smallest := i
+		for _, child := range [2]int{2*i + 1, 2*i + 2} {
+			if child < len(q.items) && q.items[child].priority < q.items[smallest].priority {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			return
+		}
+		q.items[smallest], q.items[i] = q.items[i], q.items[smallest]
+		i = smallest
+	}
+}
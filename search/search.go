@@ -0,0 +1,132 @@
+package search
+
+// NeighborFunc calls visit for each state that can be reached from state in a single move, along with the cost of
+// the move
+//
+// Constraints on the moves belong in the state: a search that may only turn after a number of straight steps keeps
+// the heading and the steps taken in the state, and only visits the moves allowed from it
+type NeighborFunc[S comparable] func(state S, visit func(next S, cost int))
+
+// Result is the outcome of a search
+type Result[S comparable] struct {
+	// Found reports whether a goal state was reached
+	Found bool
+	// Cost is the total cost of the path to the goal
+	Cost int
+	// Path lists the states from the start to the goal
+	Path []S
+	// Visited is the number of states that were expanded
+	Visited int
+}
+
+// Dijkstra finds the cheapest path from start to a state for which goal returns true; costs must not be negative
+func Dijkstra[S comparable](start S, goal func(S) bool, neighbors NeighborFunc[S]) Result[S] {
+	return AStar(start, goal, neighbors, func(S) int { return 0 })
+}
+
+// AStar finds the cheapest path from start to a state for which goal returns true, exploring the states that the
+// heuristic estimates to be closest to the goal first
+//
+// The heuristic must never overestimate the remaining cost, or a more expensive path may be returned
+func AStar[S comparable](start S, goal func(S) bool, neighbors NeighborFunc[S], heuristic func(S) int) Result[S] {
+	costs := map[S]int{start: 0}
+	parents := map[S]S{}
+	expanded := map[S]bool{}
+
+	queue := &PriorityQueue[S]{}
+	queue.Push(start, heuristic(start))
+
+	result := Result[S]{}
+	for queue.Len() > 0 {
+		state, _ := queue.Pop()
+		// the queue may hold the state more than once; only its cheapest entry counts
+		if expanded[state] {
+			continue
+		}
+		expanded[state] = true
+		result.Visited++
+
+		cost := costs[state]
+		if goal(state) {
+			result.Found, result.Cost, result.Path = true, cost, path(parents, start, state)
+			return result
+		}
+
+		neighbors(state, func(next S, step int) {
+			nextCost := cost + step
+			if known, ok := costs[next]; ok && known <= nextCost {
+				return
+			}
+			costs[next] = nextCost
+			parents[next] = state
+			queue.Push(next, nextCost+heuristic(next))
+		})
+	}
+
+	return result
+}
+
+// ZeroOneBFS finds the cheapest path from start to a state for which goal returns true when every move costs 0 or 1;
+// it panics on any other cost
+//
+// It finds the same paths as Dijkstra without the cost of a priority queue
+func ZeroOneBFS[S comparable](start S, goal func(S) bool, neighbors NeighborFunc[S]) Result[S] {
+	costs := map[S]int{start: 0}
+	parents := map[S]S{}
+	expanded := map[S]bool{}
+
+	result := Result[S]{}
+	// states are expanded a cost at a time; free moves join the current level and the others the next one
+	level := []S{start}
+	for cost := 0; len(level) > 0; cost++ {
+		var next []S
+		for i := 0; i < len(level); i++ {
+			state := level[i]
+			if expanded[state] || costs[state] != cost {
+				continue
+			}
+			expanded[state] = true
+			result.Visited++
+
+			if goal(state) {
+				result.Found, result.Cost, result.Path = true, cost, path(parents, start, state)
+				return result
+			}
+
+			neighbors(state, func(neighbor S, step int) {
+				if step != 0 && step != 1 {
+					panic("search: 0-1 BFS move costs must be 0 or 1")
+				}
+				nextCost := cost + step
+				if known, ok := costs[neighbor]; ok && known <= nextCost {
+					return
+				}
+				costs[neighbor] = nextCost
+				parents[neighbor] = state
+				if step == 0 {
+					level = append(level, neighbor)
+				} else {
+					next = append(next, neighbor)
+				}
+			})
+		}
+		level = next
+	}
+
+	return result
+}
+
+// path follows the parents back from the goal and returns the states from start to goal
+func path[S comparable](parents map[S]S, start, goal S) []S {
+	states := []S{goal}
+	for state := goal; state != start; {
+		state = parents[state]
+		states = append(states, state)
+	}
+
+	for i, j := 0, len(states)-1; i < j; i, j = i+1, j-1 {
+		states[i], states[j] = states[j], states[i]
+	}
+
+	return states
+}
@@ -0,0 +1,168 @@
+package search
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stackus/advent-of-code/geometry"
+	"github.com/stackus/advent-of-code/grid"
+)
+
+// weighted returns the neighbors of a graph given as the cost of each edge
+func weighted(graph map[string]map[string]int) NeighborFunc[string] {
+	return func(state string, visit func(next string, cost int)) {
+		for next, cost := range graph[state] {
+			visit(next, cost)
+		}
+	}
+}
+
+func TestDijkstra(t *testing.T) {
+	neighbors := weighted(map[string]map[string]int{
+		"A": {"B": 1, "D": 5},
+		"B": {"C": 1, "D": 3},
+		"C": {"D": 1},
+	})
+
+	tests := map[string]struct {
+		goal  string
+		found bool
+		cost  int
+		path  []string
+	}{
+		"cheapest of several paths": {goal: "D", found: true, cost: 3, path: []string{"A", "B", "C", "D"}},
+		"start is the goal":         {goal: "A", found: true, cost: 0, path: []string{"A"}},
+		"unreachable":               {goal: "E"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result := Dijkstra("A", func(s string) bool { return s == tc.goal }, neighbors)
+			if result.Found != tc.found || result.Cost != tc.cost || !slices.Equal(result.Path, tc.path) {
+				t.Errorf("Dijkstra() = %t, %d, %v; want %t, %d, %v", result.Found, result.Cost, result.Path,
+					tc.found, tc.cost, tc.path)
+			}
+		})
+	}
+}
+
+func TestZeroOneBFS(t *testing.T) {
+	graph := map[string]map[string]int{
+		"A": {"B": 1, "C": 0},
+		"B": {"D": 0},
+		"C": {"E": 1},
+		"E": {"D": 0, "F": 1},
+		"F": {"D": 0},
+	}
+	isD := func(s string) bool { return s == "D" }
+
+	result := ZeroOneBFS("A", isD, weighted(graph))
+	if !result.Found || result.Cost != 1 {
+		t.Fatalf("ZeroOneBFS() = %t, %d; want true, 1", result.Found, result.Cost)
+	}
+	if want := Dijkstra("A", isD, weighted(graph)); result.Cost != want.Cost {
+		t.Errorf("ZeroOneBFS() cost = %d, want the Dijkstra cost %d", result.Cost, want.Cost)
+	}
+	if result.Path[0] != "A" || result.Path[len(result.Path)-1] != "D" {
+		t.Errorf("ZeroOneBFS() path = %v, want it to run from A to D", result.Path)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("ZeroOneBFS() with a move costing 2 did not panic")
+		}
+	}()
+	ZeroOneBFS("A", isD, weighted(map[string]map[string]int{"A": {"D": 2}}))
+}
+
+func TestZeroOneBFSPrefersFreeMoves(t *testing.T) {
+	// a maze where walking along the open cells is free and breaking through a wall costs 1
+	maze := grid.Parse("" +
+		".#...\n" +
+		".#.#.\n" +
+		"...#.\n" +
+		"####.\n")
+	end := geometry.Pt(4, 3)
+	neighbors := func(p geometry.Point[int], visit func(next geometry.Point[int], cost int)) {
+		maze.Neighbors4(p.X, p.Y, func(nx, ny int, value rune) {
+			cost := 0
+			if value == '#' {
+				cost = 1
+			}
+			visit(geometry.Pt(nx, ny), cost)
+		})
+	}
+
+	result := ZeroOneBFS(geometry.Pt(0, 0), func(p geometry.Point[int]) bool { return p == end }, neighbors)
+	if !result.Found || result.Cost != 0 {
+		t.Fatalf("ZeroOneBFS() = %t, %d; want the free path", result.Found, result.Cost)
+	}
+	for _, p := range result.Path {
+		if maze.Get(p.X, p.Y) == '#' {
+			t.Errorf("path %v runs through the wall at %s", result.Path, p)
+		}
+	}
+
+	// blocking the gap forces a wall to be broken
+	maze.Set(2, 2, '#')
+	if result = ZeroOneBFS(geometry.Pt(0, 0), func(p geometry.Point[int]) bool { return p == end }, neighbors); result.Cost != 1 {
+		t.Errorf("ZeroOneBFS() cost with the gap blocked = %d, want 1", result.Cost)
+	}
+}
+
+// crucible is the state of the example of 2023 day 17: where it is and the axis of its last run of moves
+type crucible struct {
+	at   geometry.Point[int]
+	axis int
+}
+
+func TestDijkstraCrucible(t *testing.T) {
+	const city = "2413432311323\n3215453535623\n3255245654254\n3446585845452\n4546657867536\n1438598798454\n" +
+		"4457876987766\n3637877979653\n4654967986887\n4564679986453\n1224686865563\n2546548887735\n4322674655533\n"
+	const corridor = "111111111111\n999999999991\n999999999991\n999999999991\n999999999991\n"
+
+	tests := map[string]struct {
+		input    string
+		min, max int
+		want     int
+	}{
+		"crucible":                {input: city, min: 1, max: 3, want: 102},
+		"ultra crucible":          {input: city, min: 4, max: 10, want: 94},
+		"ultra crucible corridor": {input: corridor, min: 4, max: 10, want: 71},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			heat := grid.ParseFunc(tc.input, func(r rune) int { return int(r - '0') })
+			end := geometry.Pt(heat.Width-1, heat.Height-1)
+			neighbors := func(c crucible, visit func(next crucible, cost int)) {
+				for _, direction := range geometry.Directions {
+					axis := int(direction) % 2
+					if axis == c.axis {
+						continue
+					}
+					cost := 0
+					for distance := 1; distance <= tc.max; distance++ {
+						at := c.at.Move(direction, distance)
+						value, ok := heat.Lookup(at.X, at.Y)
+						if !ok {
+							break
+						}
+						cost += value
+						if distance >= tc.min {
+							visit(crucible{at: at, axis: axis}, cost)
+						}
+					}
+				}
+			}
+			isEnd := func(c crucible) bool { return c.at == end }
+			start := crucible{at: geometry.Pt(0, 0), axis: -1}
+
+			if result := Dijkstra(start, isEnd, neighbors); !result.Found || result.Cost != tc.want {
+				t.Errorf("Dijkstra() = %t, %d; want true, %d", result.Found, result.Cost, tc.want)
+			}
+			manhattan := func(c crucible) int { return c.at.Manhattan(end) }
+			if result := AStar(start, isEnd, neighbors, manhattan); !result.Found || result.Cost != tc.want {
+				t.Errorf("AStar() = %t, %d; want true, %d", result.Found, result.Cost, tc.want)
+			}
+		})
+	}
+}
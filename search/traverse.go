@@ -0,0 +1,172 @@
+package search
+
+// AdjacentFunc calls visit for each state that can be reached from state in a single move
+type AdjacentFunc[S comparable] func(state S, visit func(next S))
+
+// Limits control how far a traversal goes; the zero value places no limits
+type Limits[S comparable] struct {
+	// Goal, when set, ends the traversal as soon as a state for which it returns true is reached
+	Goal func(S) bool
+	// MaxDepth, when above zero, keeps the traversal from going more than MaxDepth moves from the start
+	MaxDepth int
+}
+
+// Traversal is the outcome of a breadth or depth first traversal
+type Traversal[S comparable] struct {
+	// Order lists the reached states in the order they were reached, starting with the start
+	Order []S
+	// Depth is the number of moves taken to reach each state
+	Depth map[S]int
+	// Parents maps each reached state, other than the start, to the state it was reached from
+	Parents map[S]S
+	// Goal is the state that ended the traversal when Found is true
+	Goal  S
+	Found bool
+
+	start S
+}
+
+// Reached reports whether the traversal reached the state
+func (t *Traversal[S]) Reached(state S) bool {
+	_, ok := t.Depth[state]
+
+	return ok
+}
+
+// PathTo returns the states on the way from the start to the state, or false when the state was not reached
+func (t *Traversal[S]) PathTo(state S) ([]S, bool) {
+	if !t.Reached(state) {
+		return nil, false
+	}
+
+	return path(t.Parents, t.start, state), true
+}
+
+// BFS visits the states reachable from start in order of the number of moves needed to reach them, so the path to
+// each state is a shortest one
+func BFS[S comparable](start S, adjacent AdjacentFunc[S], limits Limits[S]) *Traversal[S] {
+	t := newTraversal(start)
+	if t.reach(start, limits) {
+		return t
+	}
+
+	for i := 0; i < len(t.Order); i++ {
+		state := t.Order[i]
+		depth := t.Depth[state]
+		if limits.MaxDepth > 0 && depth >= limits.MaxDepth {
+			continue
+		}
+
+		found := false
+		adjacent(state, func(next S) {
+			if found || t.Reached(next) {
+				return
+			}
+			t.Depth[next] = depth + 1
+			t.Parents[next] = state
+			found = t.reach(next, limits)
+		})
+		if found {
+			return t
+		}
+	}
+
+	return t
+}
+
+// DFS visits the states reachable from start by following each branch as deep as it goes before backtracking
+//
+// The path to a state is the branch it was first reached along, which is not necessarily a shortest one. With a
+// MaxDepth, a state that is reached again along a shorter branch is walked again from there, so nothing within the
+// limit is missed
+func DFS[S comparable](start S, adjacent AdjacentFunc[S], limits Limits[S]) *Traversal[S] {
+	t := newTraversal(start)
+
+	var walk func(state S) bool
+	walk = func(state S) bool {
+		depth := t.Depth[state]
+		if limits.MaxDepth > 0 && depth >= limits.MaxDepth {
+			return false
+		}
+
+		// collect the neighbors first so that the walk doesn't run inside the callback of adjacent
+		var neighbors []S
+		adjacent(state, func(next S) {
+			neighbors = append(neighbors, next)
+		})
+		for _, next := range neighbors {
+			known, reached := t.Depth[next]
+			if reached && (limits.MaxDepth == 0 || known <= depth+1) {
+				continue
+			}
+			t.Depth[next] = depth + 1
+			t.Parents[next] = state
+			if !reached && t.reach(next, limits) {
+				return true
+			}
+			if walk(next) {
+				return true
+			}
+		}
+
+		return false
+	}
+	if !t.reach(start, limits) {
+		walk(start)
+	}
+
+	return t
+}
+
+// FloodFill returns every state reachable from start, start included
+func FloodFill[S comparable](start S, adjacent AdjacentFunc[S]) []S {
+	return BFS(start, adjacent, Limits[S]{}).Order
+}
+
+// Components splits the states into connected groups, labelling each state with the index of its group
+//
+// Groups are numbered in the order of their first state in states. Moves must work in both directions for the
+// groups to be meaningful; states reached from states that are not listed are included in the groups too
+func Components[S comparable](states []S, adjacent AdjacentFunc[S]) (labels map[S]int, groups [][]S) {
+	labels = map[S]int{}
+	for _, state := range states {
+		if _, ok := labels[state]; ok {
+			continue
+		}
+
+		group := FloodFill(state, adjacent)
+		for _, member := range group {
+			labels[member] = len(groups)
+		}
+		groups = append(groups, group)
+	}
+
+	return labels, groups
+}
+
+// Adjacency returns an AdjacentFunc for a graph given as a map from each state to the states it leads to
+func Adjacency[S comparable](graph map[S][]S) AdjacentFunc[S] {
+	return func(state S, visit func(next S)) {
+		for _, next := range graph[state] {
+			visit(next)
+		}
+	}
+}
+
+func newTraversal[S comparable](start S) *Traversal[S] {
+	return &Traversal[S]{
+		Depth:   map[S]int{start: 0},
+		Parents: map[S]S{},
+		start:   start,
+	}
+}
+
+// reach records that the state has been reached and reports whether it is the goal
+func (t *Traversal[S]) reach(state S, limits Limits[S]) bool {
+	t.Order = append(t.Order, state)
+	if limits.Goal != nil && limits.Goal(state) {
+		t.Goal, t.Found = state, true
+	}
+
+	return t.Found
+}
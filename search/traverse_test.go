@@ -0,0 +1,57 @@
+package search
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDFS(t *testing.T) {
+	graph := Adjacency(map[string][]string{
+		"A": {"B", "C"},
+		"B": {"C"},
+		"C": {"D"},
+	})
+	isD := func(s string) bool { return s == "D" }
+
+	tests := []struct {
+		name      string
+		limits    Limits[string]
+		wantFound bool
+		wantPath  []string
+	}{
+		{name: "no limits", limits: Limits[string]{Goal: isD}, wantFound: true, wantPath: []string{"A", "B", "C", "D"}},
+		{name: "goal within the depth limit along a later branch", limits: Limits[string]{Goal: isD, MaxDepth: 2},
+			wantFound: true, wantPath: []string{"A", "C", "D"}},
+		{name: "goal past the depth limit", limits: Limits[string]{Goal: isD, MaxDepth: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			traversal := DFS("A", graph, tt.limits)
+			if traversal.Found != tt.wantFound {
+				t.Fatalf("DFS() found = %v, want %v; order %v", traversal.Found, tt.wantFound, traversal.Order)
+			}
+			if !tt.wantFound {
+				return
+			}
+			if path, _ := traversal.PathTo("D"); !slices.Equal(path, tt.wantPath) {
+				t.Errorf("DFS() path = %v, want %v", path, tt.wantPath)
+			}
+		})
+	}
+}
+
+func TestDFSVisitsEachStateOnce(t *testing.T) {
+	graph := Adjacency(map[string][]string{
+		"A": {"B", "C"},
+		"B": {"C"},
+		"C": {"A", "D"},
+	})
+
+	traversal := DFS("A", graph, Limits[string]{MaxDepth: 3})
+	if want := []string{"A", "B", "C", "D"}; !slices.Equal(traversal.Order, want) {
+		t.Errorf("DFS() order = %v, want %v", traversal.Order, want)
+	}
+	if traversal.Depth["C"] != 1 || traversal.Depth["D"] != 2 {
+		t.Errorf("DFS() depths = %v, want the shortest branch for each state", traversal.Depth)
+	}
+}
@@ -0,0 +1,184 @@
+package advent_of_code
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// Verdict is the outcome of submitting an answer
+type Verdict int
+
+const (
+	VerdictUnknown Verdict = iota
+	VerdictCorrect
+	VerdictTooHigh
+	VerdictTooLow
+	VerdictWrong
+	VerdictAlreadySolved
+	VerdictRateLimited
+	VerdictWrongLevel
+)
+
+// exit codes returned by aoc submit for each verdict; 1 is left for ordinary errors
+var verdictExitCodes = map[Verdict]int{
+	VerdictUnknown:       1,
+	VerdictCorrect:       0,
+	VerdictWrong:         10,
+	VerdictTooHigh:       11,
+	VerdictTooLow:        12,
+	VerdictAlreadySolved: 13,
+	VerdictRateLimited:   14,
+	VerdictWrongLevel:    15,
+}
+
+var verdictNames = map[Verdict]string{
+	VerdictUnknown:       "unknown",
+	VerdictCorrect:       "correct",
+	VerdictTooHigh:       "too high",
+	VerdictTooLow:        "too low",
+	VerdictWrong:         "wrong",
+	VerdictAlreadySolved: "already solved",
+	VerdictRateLimited:   "rate limited",
+	VerdictWrongLevel:    "wrong level",
+}
+
+func (v Verdict) String() string {
+	return verdictNames[v]
+}
+
+// ExitCode returns the process exit code used to report the verdict
+func (v Verdict) ExitCode() int {
+	return verdictExitCodes[v]
+}
+
+// MarshalText stores verdicts by name so files recording them stay readable
+func (v Verdict) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+func (v *Verdict) UnmarshalText(text []byte) error {
+	for verdict, name := range verdictNames {
+		if name == string(text) {
+			*v = verdict
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown verdict: %q", text)
+}
+
+// SubmitResult is a classified reply to an answer submission
+type SubmitResult struct {
+	Verdict Verdict
+	// Wait is how long AoC asks for before another answer may be submitted
+	Wait time.Duration
+	// Message is the text of the reply
+	Message string
+}
+
+var (
+	waitLeftRe  = regexp.MustCompile(`you have (?:(\d+)m )?(\d+)s left to wait`)
+	waitRetryRe = regexp.MustCompile(`please wait (one|\d+) minutes? before trying again`)
+)
+
+// ParseSubmitReply classifies the reply page for an answer submitted for the given level
+func ParseSubmitReply(body []byte, level int) (*SubmitResult, error) {
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.Buffer{}
+
+	// Find the nodes
+	doc.Find("main > article").Each(func(i int, s *goquery.Selection) {
+		s.Children().Each(func(_ int, child *goquery.Selection) {
+			buf.WriteString(child.Text() + "\n")
+		})
+	})
+
+	result := &SubmitResult{
+		Message: buf.String(),
+	}
+
+	// AoC wraps lines and doubles up spaces, so normalize before matching
+	text := strings.ToLower(strings.Join(strings.Fields(result.Message), " "))
+
+	switch {
+	case strings.Contains(text, "that's the right answer"):
+		result.Verdict = VerdictCorrect
+	case strings.Contains(text, "you gave an answer too recently"):
+		result.Verdict = VerdictRateLimited
+		result.Wait = parseWaitLeft(text)
+	case strings.Contains(text, "that's not the right answer"):
+		switch {
+		case strings.Contains(text, "your answer is too high"):
+			result.Verdict = VerdictTooHigh
+		case strings.Contains(text, "your answer is too low"):
+			result.Verdict = VerdictTooLow
+		default:
+			result.Verdict = VerdictWrong
+		}
+		result.Wait = parseWaitRetry(text)
+	case strings.Contains(text, "you don't seem to be solving the right level"):
+		// level one is always the right level until it has been solved; level two is settled by ResolveLevel
+		if level == 1 {
+			result.Verdict = VerdictAlreadySolved
+		} else {
+			result.Verdict = VerdictWrongLevel
+		}
+	}
+
+	return result, nil
+}
+
+func parseWaitLeft(text string) time.Duration {
+	matches := waitLeftRe.FindStringSubmatch(text)
+	if matches == nil {
+		return 0
+	}
+
+	minutes, _ := strconv.Atoi(matches[1])
+	seconds, _ := strconv.Atoi(matches[2])
+
+	return time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+}
+
+func parseWaitRetry(text string) time.Duration {
+	matches := waitRetryRe.FindStringSubmatch(text)
+	if matches == nil {
+		return 0
+	}
+
+	if matches[1] == "one" {
+		return time.Minute
+	}
+
+	minutes, _ := strconv.Atoi(matches[1])
+
+	return time.Duration(minutes) * time.Minute
+}
+
+// ResolveLevel settles the reply AoC sends to an answer for a level it won't take; for part two the same reply is
+// sent when part one is unsolved and when part two is already solved, so once part one is known to be solved it
+// means the latter
+func (r *SubmitResult) ResolveLevel(previousSolved bool) {
+	if r.Verdict == VerdictWrongLevel && previousSolved {
+		r.Verdict = VerdictAlreadySolved
+	}
+}
+
+// String describes the result in a single line
+func (r *SubmitResult) String() string {
+	if r.Wait > 0 {
+		return fmt.Sprintf("%s; wait %s before submitting again", r.Verdict, r.Wait)
+	}
+
+	return r.Verdict.String()
+}
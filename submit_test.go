@@ -0,0 +1,129 @@
+package advent_of_code
+
+import (
+	"testing"
+	"time"
+)
+
+// reply wraps the paragraphs of an answer reply the way AoC does
+func reply(paragraphs string) []byte {
+	return []byte("<html><body><main><article>" + paragraphs + "</article></main></body></html>")
+}
+
+func TestParseSubmitReply(t *testing.T) {
+	tests := map[string]struct {
+		body  []byte
+		level int
+		want  Verdict
+		wait  time.Duration
+	}{
+		"correct": {
+			body:  reply(`<p>That's the right answer!  You are <em>one gold star</em> closer to restoring snow operations.</p>`),
+			level: 1,
+			want:  VerdictCorrect,
+		},
+		"too high": {
+			body: reply(`<p>That's not the right answer; your answer is too high.  If you're stuck, make sure you're using the
+full input data; there are also some general tips on the <a href="/2023/about">about page</a>, or you can ask for hints
+on the <a href="https://www.reddit.com/r/adventofcode/">subreddit</a>.  Please wait one minute before trying again.
+<a href="/2023/day/1">[Return to Day 1]</a></p>`),
+			level: 1,
+			want:  VerdictTooHigh,
+			wait:  time.Minute,
+		},
+		"too low": {
+			body:  reply(`<p>That's not the right answer; your answer is too low.  Please wait one minute before trying again.</p>`),
+			level: 2,
+			want:  VerdictTooLow,
+			wait:  time.Minute,
+		},
+		"wrong": {
+			body: reply(`<p>That's not the right answer.  If you're stuck, make sure you're using the full input data.
+Because you have guessed incorrectly 4 times on this puzzle, please wait 5 minutes before trying again.</p>`),
+			level: 1,
+			want:  VerdictWrong,
+			wait:  5 * time.Minute,
+		},
+		"too recently with minutes": {
+			body:  reply(`<p>You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 4m 27s left to wait.</p>`),
+			level: 1,
+			want:  VerdictRateLimited,
+			wait:  4*time.Minute + 27*time.Second,
+		},
+		"too recently with seconds": {
+			body:  reply(`<p>You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 42s left to wait.</p>`),
+			level: 1,
+			want:  VerdictRateLimited,
+			wait:  42 * time.Second,
+		},
+		"already solved": {
+			body:  reply(`<p>You don't seem to be solving the right level.  Did you already complete it?</p>`),
+			level: 1,
+			want:  VerdictAlreadySolved,
+		},
+		"wrong level": {
+			body:  reply(`<p>You don't seem to be solving the right level.  Did you already complete it?</p>`),
+			level: 2,
+			want:  VerdictWrongLevel,
+		},
+		"unknown": {
+			body:  reply(`<p>Something else entirely.</p>`),
+			level: 1,
+			want:  VerdictUnknown,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := ParseSubmitReply(tc.body, tc.level)
+			if err != nil {
+				t.Fatalf("ParseSubmitReply() error = %v", err)
+			}
+			if result.Verdict != tc.want {
+				t.Errorf("Verdict = %s, want %s", result.Verdict, tc.want)
+			}
+			if result.Wait != tc.wait {
+				t.Errorf("Wait = %s, want %s", result.Wait, tc.wait)
+			}
+		})
+	}
+}
+
+func TestVerdictText(t *testing.T) {
+	for verdict := range verdictNames {
+		text, err := verdict.MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText(%d) error = %v", verdict, err)
+		}
+
+		var got Verdict
+		if err = got.UnmarshalText(text); err != nil || got != verdict {
+			t.Errorf("UnmarshalText(%q) = %s, %v; want %s", text, got, err, verdict)
+		}
+	}
+
+	var v Verdict
+	if err := v.UnmarshalText([]byte("maybe")); err == nil {
+		t.Error("UnmarshalText(\"maybe\") error = nil, want an error")
+	}
+}
+
+func TestSubmitResultResolveLevel(t *testing.T) {
+	tests := map[string]struct {
+		verdict        Verdict
+		previousSolved bool
+		want           Verdict
+	}{
+		"part one unsolved":        {verdict: VerdictWrongLevel, want: VerdictWrongLevel},
+		"part one solved":          {verdict: VerdictWrongLevel, previousSolved: true, want: VerdictAlreadySolved},
+		"other verdicts unchanged": {verdict: VerdictTooLow, previousSolved: true, want: VerdictTooLow},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result := &SubmitResult{Verdict: tc.verdict}
+			result.ResolveLevel(tc.previousSolved)
+			if result.Verdict != tc.want {
+				t.Errorf("Verdict = %s, want %s", result.Verdict, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package advent_of_code
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const DefaultThrottleInterval = 5 * time.Second
+
+// how long a lock may be held before it is considered abandoned by a crashed process
+const throttleLockTimeout = 10 * time.Second
+
+// Throttle spaces out requests to adventofcode.com
+//
+// The time of the next allowed request is kept in a small state file, so requests stay spaced out across separate
+// runs of the tools, including runs happening at the same time
+type Throttle struct {
+	Path     string
+	Interval time.Duration
+}
+
+// Wait blocks until the next request is allowed and reserves that slot for the caller
+func (t *Throttle) Wait() error {
+	next, err := t.reserve()
+	if err != nil {
+		return err
+	}
+
+	time.Sleep(time.Until(next))
+
+	return nil
+}
+
+func (t *Throttle) reserve() (time.Time, error) {
+	unlock, err := t.lock()
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer unlock()
+
+	next := time.Now()
+
+	contents, err := os.ReadFile(t.Path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return time.Time{}, fmt.Errorf("error reading throttle state: %w", err)
+	}
+	if err == nil {
+		// an unreadable state file is simply replaced
+		if last, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(string(contents))); err == nil {
+			next = maxTime(next, last.Add(t.Interval))
+		}
+	}
+
+	err = WriteFile(t.Path, []byte(next.Format(time.RFC3339Nano)), true)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error writing throttle state: %w", err)
+	}
+
+	return next, nil
+}
+
+func (t *Throttle) lock() (func(), error) {
+	lockPath := t.Path + ".lock"
+
+	err := os.MkdirAll(filepath.Dir(lockPath), 0755)
+	if err != nil {
+		return nil, fmt.Errorf("error creating directory: %w", err)
+	}
+
+	for {
+		f, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if err == nil {
+			_ = f.Close()
+			return func() { _ = os.Remove(lockPath) }, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("error locking throttle state: %w", err)
+		}
+
+		// clear out locks left behind by a process that never released them
+		if info, err := os.Stat(lockPath); err == nil && time.Since(info.ModTime()) > throttleLockTimeout {
+			_ = os.Remove(lockPath)
+			continue
+		}
+
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,162 @@
+package advent_of_code
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newTestThrottle(t *testing.T) *Throttle {
+	t.Helper()
+
+	return &Throttle{
+		Path:     filepath.Join(t.TempDir(), "throttle", "next"),
+		Interval: time.Hour,
+	}
+}
+
+func TestThrottleReservesSlotsAnIntervalApart(t *testing.T) {
+	throttle := newTestThrottle(t)
+
+	first, err := throttle.reserve()
+	if err != nil {
+		t.Fatalf("reserve() error = %v", err)
+	}
+	if wait := time.Until(first); wait > time.Second {
+		t.Errorf("first slot is %s away, want it now", wait)
+	}
+
+	second, err := throttle.reserve()
+	if err != nil {
+		t.Fatalf("reserve() error = %v", err)
+	}
+	if got := second.Sub(first); got != throttle.Interval {
+		t.Errorf("second slot is %s after the first, want %s", got, throttle.Interval)
+	}
+
+	// the lock is released after each reservation
+	if _, err = os.Stat(throttle.Path + ".lock"); !os.IsNotExist(err) {
+		t.Errorf("lock file left behind: %v", err)
+	}
+}
+
+func TestThrottleState(t *testing.T) {
+	tests := map[string]struct {
+		state string
+		// want is how far from now the reserved slot should be
+		want time.Duration
+	}{
+		"past slot":       {state: time.Now().Add(-2 * time.Hour).Format(time.RFC3339Nano), want: 0},
+		"recent slot":     {state: time.Now().Add(-30 * time.Minute).Format(time.RFC3339Nano), want: 30 * time.Minute},
+		"unreadable file": {state: "not a time", want: 0},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			throttle := newTestThrottle(t)
+			if err := WriteFile(throttle.Path, []byte(tc.state), true); err != nil {
+				t.Fatalf("WriteFile() error = %v", err)
+			}
+
+			next, err := throttle.reserve()
+			if err != nil {
+				t.Fatalf("reserve() error = %v", err)
+			}
+			if got := time.Until(next); got < tc.want-time.Second || got > tc.want+time.Second {
+				t.Errorf("slot is %s away, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestThrottleTakesOverStaleLocks(t *testing.T) {
+	throttle := newTestThrottle(t)
+	lockPath := throttle.Path + ".lock"
+	if err := WriteFile(lockPath, nil, true); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	stale := time.Now().Add(-2 * throttleLockTimeout)
+	if err := os.Chtimes(lockPath, stale, stale); err != nil {
+		t.Fatalf("Chtimes() error = %v", err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := throttle.reserve()
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("reserve() error = %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("reserve() is still waiting on a stale lock")
+	}
+}
+
+func TestThrottleWaitsForHeldLocks(t *testing.T) {
+	throttle := newTestThrottle(t)
+	lockPath := throttle.Path + ".lock"
+	if err := WriteFile(lockPath, nil, true); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := throttle.reserve()
+		done <- err
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("reserve() went ahead while another process held the lock")
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	if err := os.Remove(lockPath); err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("reserve() error = %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("reserve() is still waiting after the lock was released")
+	}
+}
+
+func TestThrottleConcurrentReservations(t *testing.T) {
+	throttle := newTestThrottle(t)
+
+	const n = 5
+	slots := make([]time.Time, n)
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i := range slots {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			// each reservation uses its own Throttle, as separate processes would
+			other := &Throttle{Path: throttle.Path, Interval: throttle.Interval}
+			slots[i], errs[i] = other.reserve()
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			t.Fatalf("reserve() error = %v", err)
+		}
+	}
+	slices.SortFunc(slots, func(a, b time.Time) int { return a.Compare(b) })
+	for i := 1; i < n; i++ {
+		if got := slots[i].Sub(slots[i-1]); got != throttle.Interval {
+			t.Errorf("slot %d is %s after the one before, want %s", i, got, throttle.Interval)
+		}
+	}
+}